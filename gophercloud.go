@@ -0,0 +1,189 @@
+// Package gophercloud provides the core types and helpers shared by every
+// OpenStack service client in this tree: authenticated HTTP access via
+// ProviderClient/ServiceClient, the AuthOptions used to obtain a token, and
+// the small Result/Link helpers the openstack/... packages build on.
+package gophercloud
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// ProviderClient stores details that are required to interact with any
+// services within a specific provider's API the caller has identity with.
+//
+// Generally, you acquire a ProviderClient by calling the NewClient function
+// in the base "rackspace" or "openstack" package. This provides a save way
+// of initializing a ProviderClient for a specific identity service, and
+// starting a chain of discovery for service clients (e.g. Compute, Networking)
+// that all lean on the same ProviderClient.
+type ProviderClient struct {
+	// IdentityBase is the base URL used for a particular provider's identity
+	// service - it will be used when issuing authenticatation requests. It
+	// should point to the root resource of the identity service, not a
+	// specific identity version.
+	IdentityBase string
+
+	// IdentityEndpoint is the identity endpoint. This may be a specific
+	// version of the identity service. If this is the case, this endpoint is
+	// used rather than querying versions first.
+	IdentityEndpoint string
+
+	// TokenID is the ID of the most recently issued valid token.
+	TokenID string
+
+	// HTTPClient supplies the *http.Client used to make all authenticated
+	// requests through this provider. Callers set this (via
+	// openstack.NewClient's TLS-aware variants) to trust a private CA bundle,
+	// present a client certificate, or skip verification entirely against an
+	// endpoint they already trust by other means. It defaults to the zero
+	// value of http.Client when left unset.
+	HTTPClient http.Client
+}
+
+// AuthenticatedHeaders returns a map of HTTP headers that are common for all
+// authenticated service requests.
+func (client *ProviderClient) AuthenticatedHeaders() map[string]string {
+	if client.TokenID == "" {
+		return map[string]string{}
+	}
+	return map[string]string{"X-Auth-Token": client.TokenID}
+}
+
+// ServiceClient stores details required to interact with a specific service.
+type ServiceClient struct {
+	// Provider is a reference to the provider that implements this service.
+	Provider *ProviderClient
+
+	// Endpoint is the base URL of the service used for the current client.
+	Endpoint string
+}
+
+// ServiceURL constructs a URL for a resource belonging to this provider.
+func (client *ServiceClient) ServiceURL(parts ...string) string {
+	url := client.Endpoint
+	for _, part := range parts {
+		if url[len(url)-1] != '/' {
+			url += "/"
+		}
+		url += part
+	}
+	return url
+}
+
+// AuthOptions stores information needed to authenticate to an OpenStack
+// cluster.
+type AuthOptions struct {
+	// IdentityEndpoint specifies the HTTP endpoint that is required to work
+	// with the Identity API of the appropriate version.
+	IdentityEndpoint string
+
+	// Username and Password are required if using the Identity V2 password
+	// method or the Identity V3 password method. Consult with your
+	// provider's control panel to discover your account's username and
+	// password; under Identity V3 a Username is only unique within a
+	// domain, so DomainID or DomainName must also be set.
+	Username, Password string
+
+	// APIKey is deprecated: public clouds have moved off of this
+	// authentication method, and it has no Identity V3 equivalent. It is
+	// kept only so older Identity V2 callers don't break; new code should
+	// authenticate with Username/Password or TokenID instead.
+	//
+	// Deprecated: use Username/Password or TokenID.
+	APIKey string
+
+	// TokenID allows users to authenticate (possibly as another user) with an
+	// authentication token ID, instead of via Username and Password.
+	TokenID string
+
+	// TenantID and TenantName identify the project the token should be
+	// scoped to. Under Identity V3 these are honored alongside DomainID and
+	// DomainName to build the request's scope.
+	TenantID, TenantName string
+
+	// DomainID and DomainName identify the Keystone V3 domain the user
+	// belongs to. One of these is required whenever Username is set and the
+	// discovered identity endpoint speaks V3, and either can instead be used
+	// on their own to scope a token directly to a domain rather than a
+	// project.
+	DomainID, DomainName string
+
+	// AllowReauth should be set to true if you grant permission for
+	// gophercloud to cache your credentials in memory, and to allow
+	// automatic re-authentication should the provided token expire.
+	AllowReauth bool
+}
+
+// Link is used in JSON responses to refer to other, related resources.
+type Link struct {
+	Href string `mapstructure:"href"`
+	Rel  string `mapstructure:"rel"`
+}
+
+// ExtractNextURL attempts to extract the next URL from a JSON snippet
+// formatted like so:
+//
+//	{
+//		"links": [
+//			{ "href": "https://...", "rel": "next" },
+//			...
+//		]
+//	}
+func ExtractNextURL(links []Link) (string, error) {
+	for _, l := range links {
+		if l.Rel == "next" {
+			return l.Href, nil
+		}
+	}
+	return "", nil
+}
+
+// MaybeString returns a pointer to the string value passed in, unless that
+// value is "", in which case it returns nil. This is useful for request
+// bodies where the presence of a key is significant, but its absence isn't
+// an error.
+func MaybeString(original string) *string {
+	if original != "" {
+		return &original
+	}
+	return nil
+}
+
+// Result is a base struct embedded by the result of any operation that
+// returns a response body to extract. It stores the raw, decoded JSON body
+// and any error encountered while making the request, so that embedding
+// types can add their own typed Extract method on top of it.
+type Result struct {
+	Resp interface{}
+	Err  error
+}
+
+// ErrResult is a base struct embedded by the result of any operation that
+// does not return a response body to extract.
+type ErrResult struct {
+	Result
+}
+
+// ExtractErr is a function that extracts error information, or nil, from a
+// result that has no resource to extract.
+func (r ErrResult) ExtractErr() error {
+	return r.Err
+}
+
+// WaitFor polls the given predicate every second until it returns true, it
+// returns an error, or secs seconds have elapsed, whichever happens first.
+func WaitFor(secs int, predicate func() (bool, error)) error {
+	for i := 0; i < secs; i++ {
+		done, err := predicate()
+		if err != nil {
+			return err
+		}
+		if done {
+			return nil
+		}
+		time.Sleep(1 * time.Second)
+	}
+	return fmt.Errorf("A timeout occurred after %d seconds", secs)
+}
@@ -0,0 +1,8 @@
+// Package backups provides information and interaction with the backup API
+// resource for the OpenStack/Rackspace Database-as-a-Service (Trove) v1
+// extension.
+//
+// A backup is a point-in-time snapshot of a database instance that can
+// later be used to restore a new instance from, via the RestoreFromBackup
+// option on the instances package's CreateOpts.
+package backups
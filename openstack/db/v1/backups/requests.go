@@ -0,0 +1,131 @@
+package backups
+
+import (
+	"fmt"
+
+	"github.com/racker/perigee"
+	"github.com/rackspace/gophercloud"
+	"github.com/rackspace/gophercloud/openstack/utils"
+	"github.com/rackspace/gophercloud/pagination"
+)
+
+// ListOpts allows the filtering of paginated collections through the API.
+type ListOpts struct {
+	// Optional. Only return backups belonging to this instance.
+	InstanceID string
+
+	// Optional. Only return backups whose datastore matches this value.
+	Datastore string
+}
+
+// List will list all of the backups made on any instance belonging to this
+// account, optionally filtered by ListOpts.
+func List(c *gophercloud.ServiceClient, opts ListOpts) pagination.Pager {
+	q := make(map[string]string)
+	if opts.InstanceID != "" {
+		q["instance_id"] = opts.InstanceID
+	}
+	if opts.Datastore != "" {
+		q["datastore"] = opts.Datastore
+	}
+
+	u := baseURL(c) + utils.BuildQuery(q)
+
+	createPageFn := func(r pagination.LastHTTPResponse) pagination.Page {
+		return BackupPage{pagination.SinglePageBase(r)}
+	}
+
+	return pagination.NewPager(c, u, createPageFn)
+}
+
+// CreateOpts contains the values used to create a new backup of a database
+// instance.
+type CreateOpts struct {
+	// Required. The instance to back up.
+	InstanceID string
+
+	// Required. Human-readable name for the backup.
+	Name string
+
+	// Optional. Human-readable description for the backup.
+	Description string
+
+	// Optional. The ID of a prior backup to use as the parent of this one,
+	// producing an incremental backup.
+	ParentID string
+}
+
+var (
+	errInstanceIDRequired = fmt.Errorf("InstanceID is required")
+	errNameRequired       = fmt.Errorf("Name is required")
+)
+
+// Create asynchronously creates a new backup of the given instance based on
+// the configuration defined in CreateOpts.
+func Create(c *gophercloud.ServiceClient, opts CreateOpts) CreateResult {
+	var res CreateResult
+
+	if opts.InstanceID == "" {
+		res.Err = errInstanceIDRequired
+		return res
+	}
+	if opts.Name == "" {
+		res.Err = errNameRequired
+		return res
+	}
+
+	type backup struct {
+		Instance    string  `json:"instance"`
+		Name        string  `json:"name"`
+		Description *string `json:"description,omitempty"`
+		Parent      *string `json:"parent_id,omitempty"`
+	}
+
+	type request struct {
+		Backup backup `json:"backup"`
+	}
+
+	reqBody := request{Backup: backup{
+		Instance:    opts.InstanceID,
+		Name:        opts.Name,
+		Description: gophercloud.MaybeString(opts.Description),
+		Parent:      gophercloud.MaybeString(opts.ParentID),
+	}}
+
+	_, res.Err = perigee.Request("POST", baseURL(c), perigee.Options{
+		MoreHeaders:  c.Provider.AuthenticatedHeaders(),
+		CustomClient: &c.Provider.HTTPClient,
+		ReqBody:      &reqBody,
+		Results:      &res.Resp,
+		OkCodes:      []int{202},
+	})
+
+	return res
+}
+
+// Get retrieves a particular backup based on its unique ID.
+func Get(c *gophercloud.ServiceClient, id string) GetResult {
+	var res GetResult
+
+	_, res.Err = perigee.Request("GET", resourceURL(c, id), perigee.Options{
+		MoreHeaders:  c.Provider.AuthenticatedHeaders(),
+		CustomClient: &c.Provider.HTTPClient,
+		Results:      &res.Resp,
+		OkCodes:      []int{200},
+	})
+
+	return res
+}
+
+// Delete will permanently delete a backup based on its unique ID.
+func Delete(c *gophercloud.ServiceClient, id string) DeleteResult {
+	var res DeleteResult
+
+	_, res.Err = perigee.Request("DELETE", resourceURL(c, id), perigee.Options{
+		MoreHeaders:  c.Provider.AuthenticatedHeaders(),
+		CustomClient: &c.Provider.HTTPClient,
+		OkCodes:      []int{202},
+	})
+
+	return res
+}
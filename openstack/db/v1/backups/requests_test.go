@@ -0,0 +1,75 @@
+package backups
+
+import (
+	"testing"
+
+	"github.com/rackspace/gophercloud/pagination"
+	th "github.com/rackspace/gophercloud/testhelper"
+	fake "github.com/rackspace/gophercloud/testhelper/client"
+)
+
+const instanceID = "{instanceID}"
+
+func TestCreate(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	HandleCreateBackupSuccessfully(t)
+
+	opts := CreateOpts{InstanceID: instanceID, Name: "testbackup"}
+
+	res := Create(fake.ServiceClient(), opts)
+	th.AssertNoErr(t, res.Err)
+}
+
+func TestList(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	HandleListBackupsSuccessfully(t)
+
+	expectedBackups := []Backup{
+		Backup{ID: "{backupID}", Name: "testbackup", InstanceID: instanceID},
+	}
+
+	pages := 0
+	err := List(fake.ServiceClient(), ListOpts{}).EachPage(func(page pagination.Page) (bool, error) {
+		pages++
+
+		actual, err := ExtractBackups(page)
+		if err != nil {
+			return false, err
+		}
+
+		th.CheckDeepEquals(t, expectedBackups[0].Name, actual[0].Name)
+
+		return true, nil
+	})
+
+	th.AssertNoErr(t, err)
+
+	if pages != 1 {
+		t.Errorf("Expected 1 page, saw %d", pages)
+	}
+}
+
+func TestGet(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	HandleGetBackupSuccessfully(t, "{backupID}")
+
+	actual, err := Get(fake.ServiceClient(), "{backupID}").Extract()
+	th.AssertNoErr(t, err)
+	th.CheckDeepEquals(t, "testbackup", actual.Name)
+}
+
+func TestDelete(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	HandleDeleteBackupSuccessfully(t, "{backupID}")
+
+	res := Delete(fake.ServiceClient(), "{backupID}")
+	th.AssertNoErr(t, res.Err)
+}
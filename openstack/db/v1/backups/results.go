@@ -0,0 +1,82 @@
+package backups
+
+import (
+	"github.com/mitchellh/mapstructure"
+	"github.com/rackspace/gophercloud"
+	"github.com/rackspace/gophercloud/pagination"
+)
+
+// Backup represents a point-in-time snapshot of a database instance.
+type Backup struct {
+	ID          string `mapstructure:"id"`
+	Name        string `mapstructure:"name"`
+	Description string `mapstructure:"description"`
+	InstanceID  string `mapstructure:"instance_id"`
+	Status      string `mapstructure:"status"`
+	ParentID    string `mapstructure:"parent_id"`
+	Datastore   struct {
+		Type    string `mapstructure:"type"`
+		Version string `mapstructure:"version"`
+	} `mapstructure:"datastore"`
+	Created string `mapstructure:"created"`
+	Updated string `mapstructure:"updated"`
+}
+
+// BackupPage represents a single page of a paginated backup collection.
+type BackupPage struct {
+	pagination.SinglePageBase
+}
+
+// IsEmpty checks to see whether the collection is empty.
+func (page BackupPage) IsEmpty() (bool, error) {
+	backups, err := ExtractBackups(page)
+	return len(backups) == 0, err
+}
+
+// ExtractBackups will convert a generic pagination struct into a more
+// relevant slice of Backup structs.
+func ExtractBackups(page pagination.Page) ([]Backup, error) {
+	casted := page.(BackupPage).Body
+
+	var resp struct {
+		Backups []Backup `mapstructure:"backups"`
+	}
+
+	err := mapstructure.Decode(casted, &resp)
+
+	return resp.Backups, err
+}
+
+type commonResult struct {
+	gophercloud.Result
+}
+
+// Extract interprets a result as a Backup.
+func (r commonResult) Extract() (*Backup, error) {
+	if r.Err != nil {
+		return nil, r.Err
+	}
+
+	var res struct {
+		Backup Backup `mapstructure:"backup"`
+	}
+
+	err := mapstructure.Decode(r.Resp, &res)
+
+	return &res.Backup, err
+}
+
+// CreateResult represents the result of a Create operation.
+type CreateResult struct {
+	commonResult
+}
+
+// GetResult represents the result of a Get operation.
+type GetResult struct {
+	commonResult
+}
+
+// DeleteResult represents the result of a Delete operation.
+type DeleteResult struct {
+	gophercloud.ErrResult
+}
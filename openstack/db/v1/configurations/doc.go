@@ -0,0 +1,8 @@
+// Package configurations provides information and interaction with the
+// configuration group API resource for the OpenStack/Rackspace
+// Database-as-a-Service (Trove) v1 extension.
+//
+// A configuration group is a named set of datastore parameter overrides
+// (e.g. max_connections) that can be attached to one or more database
+// instances.
+package configurations
@@ -0,0 +1,226 @@
+package configurations
+
+import (
+	"fmt"
+
+	"github.com/racker/perigee"
+	"github.com/rackspace/gophercloud"
+	"github.com/rackspace/gophercloud/pagination"
+)
+
+// List will list all of the configuration groups associated with the
+// current tenant.
+func List(c *gophercloud.ServiceClient) pagination.Pager {
+	createPageFn := func(r pagination.LastHTTPResponse) pagination.Page {
+		return ConfigPage{pagination.SinglePageBase(r)}
+	}
+
+	return pagination.NewPager(c, baseURL(c), createPageFn)
+}
+
+// CreateOpts contains the values used to create a new configuration group.
+type CreateOpts struct {
+	// Required. Human-readable name for the configuration group.
+	Name string
+
+	// Optional. Human-readable description for the configuration group.
+	Description string
+
+	// Required. The datastore parameter values this configuration group
+	// overrides, e.g. {"max_connections": "300"}.
+	Values map[string]interface{}
+
+	// Optional. The datastore this configuration group applies to.
+	Datastore string
+
+	// Optional. The version of Datastore this configuration group applies
+	// to. The datastore object is left out of the request when both Datastore
+	// and DatastoreVersion are empty.
+	DatastoreVersion string
+}
+
+var errNameRequired = fmt.Errorf("Name is required")
+
+// Create asynchronously creates a new configuration group based on the
+// configuration defined in CreateOpts.
+func Create(c *gophercloud.ServiceClient, opts CreateOpts) CreateResult {
+	var res CreateResult
+
+	if opts.Name == "" {
+		res.Err = errNameRequired
+		return res
+	}
+
+	type datastore struct {
+		Type    string `json:"type,omitempty"`
+		Version string `json:"version,omitempty"`
+	}
+
+	type config struct {
+		Name        string                 `json:"name"`
+		Description *string                `json:"description,omitempty"`
+		Values      map[string]interface{} `json:"values,omitempty"`
+		Datastore   *datastore             `json:"datastore,omitempty"`
+	}
+
+	type request struct {
+		Config config `json:"configuration"`
+	}
+
+	reqBody := request{Config: config{
+		Name:        opts.Name,
+		Description: gophercloud.MaybeString(opts.Description),
+		Values:      opts.Values,
+	}}
+
+	if opts.Datastore != "" || opts.DatastoreVersion != "" {
+		reqBody.Config.Datastore = &datastore{Type: opts.Datastore, Version: opts.DatastoreVersion}
+	}
+
+	_, res.Err = perigee.Request("POST", baseURL(c), perigee.Options{
+		MoreHeaders:  c.Provider.AuthenticatedHeaders(),
+		CustomClient: &c.Provider.HTTPClient,
+		ReqBody:      &reqBody,
+		Results:      &res.Resp,
+		OkCodes:      []int{200},
+	})
+
+	return res
+}
+
+// Get retrieves a particular configuration group based on its unique ID.
+func Get(c *gophercloud.ServiceClient, id string) GetResult {
+	var res GetResult
+
+	_, res.Err = perigee.Request("GET", resourceURL(c, id), perigee.Options{
+		MoreHeaders:  c.Provider.AuthenticatedHeaders(),
+		CustomClient: &c.Provider.HTTPClient,
+		Results:      &res.Resp,
+		OkCodes:      []int{200},
+	})
+
+	return res
+}
+
+// UpdateOpts contains the values used to update an existing configuration
+// group. Values are merged into the existing set; to remove a key entirely,
+// use Replace instead.
+type UpdateOpts struct {
+	Name        string
+	Description string
+	Values      map[string]interface{}
+}
+
+// Update will modify an existing configuration group by merging the given
+// values into it.
+func Update(c *gophercloud.ServiceClient, id string, opts UpdateOpts) UpdateResult {
+	var res UpdateResult
+
+	type config struct {
+		Name        *string                `json:"name,omitempty"`
+		Description *string                `json:"description,omitempty"`
+		Values      map[string]interface{} `json:"values,omitempty"`
+	}
+
+	type request struct {
+		Config config `json:"configuration"`
+	}
+
+	reqBody := request{Config: config{
+		Name:        gophercloud.MaybeString(opts.Name),
+		Description: gophercloud.MaybeString(opts.Description),
+		Values:      opts.Values,
+	}}
+
+	_, res.Err = perigee.Request("PATCH", resourceURL(c, id), perigee.Options{
+		MoreHeaders:  c.Provider.AuthenticatedHeaders(),
+		CustomClient: &c.Provider.HTTPClient,
+		ReqBody:      &reqBody,
+		OkCodes:      []int{200},
+	})
+
+	return res
+}
+
+// Replace will overwrite an existing configuration group's Values entirely,
+// rather than merging them in like Update does: any key present in the
+// group but absent from opts.Values is removed.
+func Replace(c *gophercloud.ServiceClient, id string, opts UpdateOpts) UpdateResult {
+	var res UpdateResult
+
+	type config struct {
+		Name        *string                `json:"name,omitempty"`
+		Description *string                `json:"description,omitempty"`
+		Values      map[string]interface{} `json:"values,omitempty"`
+	}
+
+	type request struct {
+		Config config `json:"configuration"`
+	}
+
+	reqBody := request{Config: config{
+		Name:        gophercloud.MaybeString(opts.Name),
+		Description: gophercloud.MaybeString(opts.Description),
+		Values:      opts.Values,
+	}}
+
+	_, res.Err = perigee.Request("PUT", resourceURL(c, id), perigee.Options{
+		MoreHeaders:  c.Provider.AuthenticatedHeaders(),
+		CustomClient: &c.Provider.HTTPClient,
+		ReqBody:      &reqBody,
+		OkCodes:      []int{200},
+	})
+
+	return res
+}
+
+// Delete will permanently delete a configuration group based on its unique
+// ID. It must not be attached to any instances.
+func Delete(c *gophercloud.ServiceClient, id string) DeleteResult {
+	var res DeleteResult
+
+	_, res.Err = perigee.Request("DELETE", resourceURL(c, id), perigee.Options{
+		MoreHeaders:  c.Provider.AuthenticatedHeaders(),
+		CustomClient: &c.Provider.HTTPClient,
+		OkCodes:      []int{202},
+	})
+
+	return res
+}
+
+// ListInstances will list the instances a configuration group is currently
+// attached to.
+func ListInstances(c *gophercloud.ServiceClient, id string) pagination.Pager {
+	createPageFn := func(r pagination.LastHTTPResponse) pagination.Page {
+		return AttachedInstancePage{pagination.SinglePageBase(r)}
+	}
+
+	return pagination.NewPager(c, instancesURL(c, id), createPageFn)
+}
+
+// ListDatastoreParams will list the configuration parameters that are valid
+// for the given datastore and version, along with their type and
+// constraints. Check this before submitting a CreateOpts.Values map to
+// avoid a 400 from the API.
+func ListDatastoreParams(c *gophercloud.ServiceClient, datastore, versionID string) pagination.Pager {
+	createPageFn := func(r pagination.LastHTTPResponse) pagination.Page {
+		return ParamPage{pagination.SinglePageBase(r)}
+	}
+
+	return pagination.NewPager(c, paramsURL(c, datastore, versionID), createPageFn)
+}
+
+// GetDatastoreParam retrieves the constraints for a single configuration
+// parameter of the given datastore and version.
+func GetDatastoreParam(c *gophercloud.ServiceClient, datastore, versionID, param string) GetParamResult {
+	var res GetParamResult
+
+	_, res.Err = perigee.Request("GET", paramURL(c, datastore, versionID, param), perigee.Options{
+		MoreHeaders:  c.Provider.AuthenticatedHeaders(),
+		CustomClient: &c.Provider.HTTPClient,
+		Results:      &res.Resp,
+		OkCodes:      []int{200},
+	})
+
+	return res
+}
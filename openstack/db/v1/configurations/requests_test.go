@@ -0,0 +1,49 @@
+package configurations
+
+import (
+	"testing"
+
+	th "github.com/rackspace/gophercloud/testhelper"
+	fake "github.com/rackspace/gophercloud/testhelper/client"
+)
+
+func TestCreate(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	HandleCreateConfigSuccessfully(t)
+
+	opts := CreateOpts{
+		Name:             "testconfig",
+		Values:           map[string]interface{}{"max_connections": "300"},
+		Datastore:        "mysql",
+		DatastoreVersion: "5.6",
+	}
+
+	res := Create(fake.ServiceClient(), opts)
+	th.AssertNoErr(t, res.Err)
+}
+
+func TestUpdate(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	HandleUpdateConfigSuccessfully(t, "{configID}")
+
+	opts := UpdateOpts{Values: map[string]interface{}{"max_connections": "400"}}
+
+	res := Update(fake.ServiceClient(), "{configID}", opts)
+	th.AssertNoErr(t, res.Err)
+}
+
+func TestReplace(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	HandleReplaceConfigSuccessfully(t, "{configID}")
+
+	opts := UpdateOpts{Values: map[string]interface{}{"max_connections": "400"}}
+
+	res := Replace(fake.ServiceClient(), "{configID}", opts)
+	th.AssertNoErr(t, res.Err)
+}
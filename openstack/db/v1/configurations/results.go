@@ -0,0 +1,170 @@
+package configurations
+
+import (
+	"github.com/mitchellh/mapstructure"
+	"github.com/rackspace/gophercloud"
+	"github.com/rackspace/gophercloud/pagination"
+)
+
+// Config represents a configuration group.
+type Config struct {
+	ID          string                 `mapstructure:"id"`
+	Name        string                 `mapstructure:"name"`
+	Description string                 `mapstructure:"description"`
+	Values      map[string]interface{} `mapstructure:"values"`
+	Datastore   struct {
+		Type    string `mapstructure:"type"`
+		Version string `mapstructure:"version"`
+	} `mapstructure:"datastore"`
+}
+
+// ConfigPage represents a single page of a paginated configuration group
+// collection.
+type ConfigPage struct {
+	pagination.SinglePageBase
+}
+
+// IsEmpty checks to see whether the collection is empty.
+func (page ConfigPage) IsEmpty() (bool, error) {
+	configs, err := ExtractConfigs(page)
+	return len(configs) == 0, err
+}
+
+// ExtractConfigs will convert a generic pagination struct into a more
+// relevant slice of Config structs.
+func ExtractConfigs(page pagination.Page) ([]Config, error) {
+	casted := page.(ConfigPage).Body
+
+	var resp struct {
+		Configs []Config `mapstructure:"configurations"`
+	}
+
+	err := mapstructure.Decode(casted, &resp)
+
+	return resp.Configs, err
+}
+
+// AttachedInstance is a minimal representation of a database instance that
+// a configuration group is currently attached to.
+type AttachedInstance struct {
+	ID   string `mapstructure:"id"`
+	Name string `mapstructure:"name"`
+}
+
+// AttachedInstancePage represents a single page of instances attached to a
+// configuration group.
+type AttachedInstancePage struct {
+	pagination.SinglePageBase
+}
+
+// IsEmpty checks to see whether the collection is empty.
+func (page AttachedInstancePage) IsEmpty() (bool, error) {
+	instances, err := ExtractAttachedInstances(page)
+	return len(instances) == 0, err
+}
+
+// ExtractAttachedInstances will convert a generic pagination struct into a
+// slice of AttachedInstance structs.
+func ExtractAttachedInstances(page pagination.Page) ([]AttachedInstance, error) {
+	casted := page.(AttachedInstancePage).Body
+
+	var resp struct {
+		Instances []AttachedInstance `mapstructure:"instances"`
+	}
+
+	err := mapstructure.Decode(casted, &resp)
+
+	return resp.Instances, err
+}
+
+// Param represents the constraints on a single datastore configuration
+// parameter.
+type Param struct {
+	Name       string      `mapstructure:"name"`
+	RestartRequired bool   `mapstructure:"restart_required"`
+	Max        float64     `mapstructure:"max"`
+	Min        float64     `mapstructure:"min"`
+	Type       string      `mapstructure:"type"`
+}
+
+// ParamPage represents a single page of a paginated configuration
+// parameter collection.
+type ParamPage struct {
+	pagination.SinglePageBase
+}
+
+// IsEmpty checks to see whether the collection is empty.
+func (page ParamPage) IsEmpty() (bool, error) {
+	params, err := ExtractParams(page)
+	return len(params) == 0, err
+}
+
+// ExtractParams will convert a generic pagination struct into a slice of
+// Param structs.
+func ExtractParams(page pagination.Page) ([]Param, error) {
+	casted := page.(ParamPage).Body
+
+	var resp struct {
+		Params []Param `mapstructure:"configuration-parameters"`
+	}
+
+	err := mapstructure.Decode(casted, &resp)
+
+	return resp.Params, err
+}
+
+type commonResult struct {
+	gophercloud.Result
+}
+
+// Extract interprets a result as a Config.
+func (r commonResult) Extract() (*Config, error) {
+	if r.Err != nil {
+		return nil, r.Err
+	}
+
+	var res struct {
+		Config Config `mapstructure:"configuration"`
+	}
+
+	err := mapstructure.Decode(r.Resp, &res)
+
+	return &res.Config, err
+}
+
+// CreateResult represents the result of a Create operation.
+type CreateResult struct {
+	commonResult
+}
+
+// GetResult represents the result of a Get operation.
+type GetResult struct {
+	commonResult
+}
+
+// UpdateResult represents the result of an Update operation.
+type UpdateResult struct {
+	gophercloud.ErrResult
+}
+
+// DeleteResult represents the result of a Delete operation.
+type DeleteResult struct {
+	gophercloud.ErrResult
+}
+
+// GetParamResult represents the result of a GetDatastoreParam operation.
+type GetParamResult struct {
+	gophercloud.Result
+}
+
+// Extract interprets a GetParamResult as a Param.
+func (r GetParamResult) Extract() (*Param, error) {
+	if r.Err != nil {
+		return nil, r.Err
+	}
+
+	var p Param
+	err := mapstructure.Decode(r.Resp, &p)
+
+	return &p, err
+}
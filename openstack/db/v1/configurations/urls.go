@@ -0,0 +1,23 @@
+package configurations
+
+import "github.com/rackspace/gophercloud"
+
+func baseURL(c *gophercloud.ServiceClient) string {
+	return c.ServiceURL("configurations")
+}
+
+func resourceURL(c *gophercloud.ServiceClient, id string) string {
+	return c.ServiceURL("configurations", id)
+}
+
+func instancesURL(c *gophercloud.ServiceClient, id string) string {
+	return c.ServiceURL("configurations", id, "instances")
+}
+
+func paramsURL(c *gophercloud.ServiceClient, datastore, versionID string) string {
+	return c.ServiceURL("datastores", datastore, "versions", versionID, "parameters")
+}
+
+func paramURL(c *gophercloud.ServiceClient, datastore, versionID, param string) string {
+	return c.ServiceURL("datastores", datastore, "versions", versionID, "parameters", param)
+}
@@ -0,0 +1,7 @@
+// Package instances provides information and interaction with the database
+// instance API resource for the OpenStack/Rackspace Database-as-a-Service
+// (Trove) v1 extension.
+//
+// An instance is the virtual machine that hosts the databases, users, and
+// configuration group managed by the sibling packages in this tree.
+package instances
@@ -0,0 +1,167 @@
+package instances
+
+import (
+	"fmt"
+
+	"github.com/racker/perigee"
+	"github.com/rackspace/gophercloud"
+)
+
+// Volume is the size and type of block storage to attach to the instance.
+type Volume struct {
+	Size int    `json:"size"`
+	Type string `json:"type,omitempty"`
+}
+
+// CreateOpts contains the values used to create a new database instance.
+type CreateOpts struct {
+	// Required. Human-readable name for the instance.
+	Name string
+
+	// Required. The size (in GB) and type of the block storage volume that
+	// will hold the instance's data.
+	Volume Volume
+
+	// Required. The reference to the flavor (RAM/CPU profile) to provision.
+	FlavorRef string
+
+	// Optional. Databases to create on the instance as part of provisioning.
+	Databases []DatabaseOpts
+
+	// Optional. Users to create on the instance as part of provisioning.
+	Users []UserOpts
+
+	// Optional. The ID of a prior backup to restore the instance's data
+	// from. Mutually exclusive with providing Databases/Users, since the
+	// restored backup supplies its own.
+	RestoreFromBackup string
+
+	// Optional. The ID of a configuration group to attach at creation time.
+	ConfigurationID string
+}
+
+// DatabaseOpts mirrors databases.CreateOpts for the purposes of specifying
+// databases to create as part of CreateOpts.
+type DatabaseOpts struct {
+	Name    string `json:"name"`
+	CharSet string `json:"character_set,omitempty"`
+	Collate string `json:"collate,omitempty"`
+}
+
+// UserOpts mirrors users.CreateOpts for the purposes of specifying users to
+// create as part of CreateOpts.
+type UserOpts struct {
+	Name      string   `json:"name"`
+	Password  string   `json:"password"`
+	Databases []string `json:"databases,omitempty"`
+}
+
+var errNameRequired = fmt.Errorf("Name is required")
+
+// Create asynchronously provisions a new database instance based on the
+// configuration defined in CreateOpts.
+func Create(c *gophercloud.ServiceClient, opts CreateOpts) CreateResult {
+	var res CreateResult
+
+	if opts.Name == "" {
+		res.Err = errNameRequired
+		return res
+	}
+
+	type restorePoint struct {
+		BackupRef string `json:"backupRef"`
+	}
+
+	type instance struct {
+		Name            string         `json:"name"`
+		FlavorRef       string         `json:"flavorRef"`
+		Volume          Volume         `json:"volume"`
+		Databases       []DatabaseOpts `json:"databases,omitempty"`
+		Users           []UserOpts     `json:"users,omitempty"`
+		RestorePoint    *restorePoint  `json:"restorePoint,omitempty"`
+		ConfigurationID *string        `json:"configuration,omitempty"`
+	}
+
+	type request struct {
+		Instance instance `json:"instance"`
+	}
+
+	reqBody := request{Instance: instance{
+		Name:            opts.Name,
+		FlavorRef:       opts.FlavorRef,
+		Volume:          opts.Volume,
+		Databases:       opts.Databases,
+		Users:           opts.Users,
+		ConfigurationID: gophercloud.MaybeString(opts.ConfigurationID),
+	}}
+
+	if opts.RestoreFromBackup != "" {
+		reqBody.Instance.RestorePoint = &restorePoint{BackupRef: opts.RestoreFromBackup}
+	}
+
+	_, res.Err = perigee.Request("POST", baseURL(c), perigee.Options{
+		MoreHeaders:  c.Provider.AuthenticatedHeaders(),
+		CustomClient: &c.Provider.HTTPClient,
+		ReqBody:      &reqBody,
+		Results:      &res.Resp,
+		OkCodes:      []int{200},
+	})
+
+	return res
+}
+
+// Get retrieves a particular database instance based on its unique ID.
+func Get(c *gophercloud.ServiceClient, id string) GetResult {
+	var res GetResult
+
+	_, res.Err = perigee.Request("GET", resourceURL(c, id), perigee.Options{
+		MoreHeaders:  c.Provider.AuthenticatedHeaders(),
+		CustomClient: &c.Provider.HTTPClient,
+		Results:      &res.Resp,
+		OkCodes:      []int{200},
+	})
+
+	return res
+}
+
+// AttachConfigurationGroup attaches the given configuration group to an
+// instance, applying its parameter overrides.
+func AttachConfigurationGroup(c *gophercloud.ServiceClient, instanceID, configID string) UpdateResult {
+	var res UpdateResult
+
+	type instance struct {
+		ConfigurationID string `json:"configuration"`
+	}
+
+	type request struct {
+		Instance instance `json:"instance"`
+	}
+
+	_, res.Err = perigee.Request("PUT", resourceURL(c, instanceID), perigee.Options{
+		MoreHeaders:  c.Provider.AuthenticatedHeaders(),
+		CustomClient: &c.Provider.HTTPClient,
+		ReqBody:      &request{Instance: instance{ConfigurationID: configID}},
+		OkCodes:      []int{202},
+	})
+
+	return res
+}
+
+// DetachConfigurationGroup removes whatever configuration group is
+// currently attached to an instance, reverting it to datastore defaults.
+//
+// Unlike AttachConfigurationGroup, this cannot be expressed by PUTing an
+// empty instance object: Trove ignores the absence of a "configuration" key
+// rather than treating it as "clear the configuration", so detaching has to
+// go through the dedicated /instances/{id}/configuration sub-resource.
+func DetachConfigurationGroup(c *gophercloud.ServiceClient, instanceID string) UpdateResult {
+	var res UpdateResult
+
+	_, res.Err = perigee.Request("DELETE", configurationURL(c, instanceID), perigee.Options{
+		MoreHeaders:  c.Provider.AuthenticatedHeaders(),
+		CustomClient: &c.Provider.HTTPClient,
+		OkCodes:      []int{202},
+	})
+
+	return res
+}
@@ -0,0 +1,46 @@
+package instances
+
+import (
+	"testing"
+
+	th "github.com/rackspace/gophercloud/testhelper"
+	fake "github.com/rackspace/gophercloud/testhelper/client"
+)
+
+const instanceID = "{instanceID}"
+
+func TestCreate(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	HandleCreateInstanceSuccessfully(t)
+
+	opts := CreateOpts{
+		Name:      "testinstance",
+		FlavorRef: "1",
+		Volume:    Volume{Size: 1},
+	}
+
+	res := Create(fake.ServiceClient(), opts)
+	th.AssertNoErr(t, res.Err)
+}
+
+func TestAttachConfigurationGroup(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	HandleAttachConfigurationGroupSuccessfully(t, instanceID, "{configID}")
+
+	res := AttachConfigurationGroup(fake.ServiceClient(), instanceID, "{configID}")
+	th.AssertNoErr(t, res.Err)
+}
+
+func TestDetachConfigurationGroup(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	HandleDetachConfigurationGroupSuccessfully(t, instanceID)
+
+	res := DetachConfigurationGroup(fake.ServiceClient(), instanceID)
+	th.AssertNoErr(t, res.Err)
+}
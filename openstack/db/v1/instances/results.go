@@ -0,0 +1,53 @@
+package instances
+
+import (
+	"github.com/mitchellh/mapstructure"
+	"github.com/rackspace/gophercloud"
+)
+
+// Instance represents a database instance.
+type Instance struct {
+	ID              string `mapstructure:"id"`
+	Name            string `mapstructure:"name"`
+	Status          string `mapstructure:"status"`
+	FlavorRef       string `mapstructure:"flavor"`
+	ConfigurationID string `mapstructure:"configuration"`
+	Volume          struct {
+		Size int `mapstructure:"size"`
+	} `mapstructure:"volume"`
+}
+
+type commonResult struct {
+	gophercloud.Result
+}
+
+// Extract interprets a result as an Instance.
+func (r commonResult) Extract() (*Instance, error) {
+	if r.Err != nil {
+		return nil, r.Err
+	}
+
+	var res struct {
+		Instance Instance `mapstructure:"instance"`
+	}
+
+	err := mapstructure.Decode(r.Resp, &res)
+
+	return &res.Instance, err
+}
+
+// CreateResult represents the result of a Create operation.
+type CreateResult struct {
+	commonResult
+}
+
+// GetResult represents the result of a Get operation.
+type GetResult struct {
+	commonResult
+}
+
+// UpdateResult represents the result of an AttachConfigurationGroup or
+// DetachConfigurationGroup operation.
+type UpdateResult struct {
+	gophercloud.ErrResult
+}
@@ -0,0 +1,8 @@
+// Package users provides information and interaction with the user API
+// resource for the OpenStack/Rackspace Database-as-a-Service (Trove) v1
+// extension.
+//
+// Users are created on a database instance and may be granted access to one
+// or more of that instance's databases. See the sibling databases package
+// for managing the databases themselves.
+package users
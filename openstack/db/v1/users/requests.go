@@ -0,0 +1,230 @@
+package users
+
+import (
+	"fmt"
+
+	"github.com/racker/perigee"
+	"github.com/rackspace/gophercloud"
+	"github.com/rackspace/gophercloud/pagination"
+)
+
+// CreateOpts is the struct responsible for configuring a new user; often as
+// part of a batch of users for a single database instance.
+type CreateOpts struct {
+	// Required. Specifies a name for the user. Has to be unique within an
+	// instance.
+	Name string
+
+	// Required. Specifies a password for the user.
+	Password string
+
+	// Optional. Specifies the host from which a user is allowed to connect
+	// to the database. Possible values are a string containing an IPv4
+	// address or "%" to allow connecting from any host.
+	Host string
+
+	// Databases this user should be granted access to upon creation.
+	Databases []string
+}
+
+// BatchCreateOpts allows multiple users to be created at once.
+type BatchCreateOpts []CreateOpts
+
+var errNameRequired = fmt.Errorf("Name is required")
+
+// Create asynchronously provisions a new user for the specified database
+// instance based on the configuration defined in CreateOpts.
+func Create(c *gophercloud.ServiceClient, instanceID string, opts BatchCreateOpts) CreateResult {
+	var res CreateResult
+
+	type user struct {
+		Name      string   `json:"name"`
+		Password  string   `json:"password"`
+		Host      string   `json:"host,omitempty"`
+		Databases []dbName `json:"databases,omitempty"`
+	}
+
+	type request struct {
+		Users []user `json:"users"`
+	}
+
+	reqUsers := make([]user, len(opts))
+	for i, o := range opts {
+		if o.Name == "" {
+			res.Err = errNameRequired
+			return res
+		}
+
+		reqUsers[i] = user{
+			Name:      o.Name,
+			Password:  o.Password,
+			Host:      o.Host,
+			Databases: dbNames(o.Databases),
+		}
+	}
+
+	_, res.Err = perigee.Request("POST", baseURL(c, instanceID), perigee.Options{
+		MoreHeaders:  c.Provider.AuthenticatedHeaders(),
+		CustomClient: &c.Provider.HTTPClient,
+		ReqBody:      &request{Users: reqUsers},
+		OkCodes:      []int{202},
+	})
+
+	return res
+}
+
+// List will list all the users associated with a particular database
+// instance, along with their databases and host information.
+func List(c *gophercloud.ServiceClient, instanceID string) pagination.Pager {
+	createPageFn := func(r pagination.LastHTTPResponse) pagination.Page {
+		return UserPage{pagination.SinglePageBase(r)}
+	}
+
+	return pagination.NewPager(c, baseURL(c, instanceID), createPageFn)
+}
+
+// Delete will permanently delete a user from a specified database instance.
+func Delete(c *gophercloud.ServiceClient, instanceID, userName string) DeleteResult {
+	var res DeleteResult
+
+	_, res.Err = perigee.Request("DELETE", userURL(c, instanceID, userName), perigee.Options{
+		MoreHeaders:  c.Provider.AuthenticatedHeaders(),
+		CustomClient: &c.Provider.HTTPClient,
+		OkCodes:      []int{202},
+	})
+
+	return res
+}
+
+// UpdatePasswordOpts represents a single {name, password} pair when
+// changing the passwords of one or more users on an instance in a single
+// call.
+type UpdatePasswordOpts struct {
+	Name     string
+	Password string
+}
+
+// UpdatePasswordsOpts allows multiple users' passwords to be changed at
+// once.
+type UpdatePasswordsOpts []UpdatePasswordOpts
+
+// ChangePassword changes the password for one or more users on the
+// specified instance.
+func ChangePassword(c *gophercloud.ServiceClient, instanceID string, opts UpdatePasswordsOpts) ChangePasswordResult {
+	var res ChangePasswordResult
+
+	type user struct {
+		Name     string `json:"name"`
+		Password string `json:"password"`
+	}
+
+	type request struct {
+		Users []user `json:"users"`
+	}
+
+	reqUsers := make([]user, len(opts))
+	for i, o := range opts {
+		reqUsers[i] = user{Name: o.Name, Password: o.Password}
+	}
+
+	_, res.Err = perigee.Request("PUT", baseURL(c, instanceID), perigee.Options{
+		MoreHeaders:  c.Provider.AuthenticatedHeaders(),
+		CustomClient: &c.Provider.HTTPClient,
+		ReqBody:      &request{Users: reqUsers},
+		OkCodes:      []int{202},
+	})
+
+	return res
+}
+
+// UpdateOpts allows a user's name, password, or host to be changed. Every
+// field is optional: an empty field is left out of the request, and the
+// corresponding attribute of the user is left unchanged.
+type UpdateOpts struct {
+	Name     string
+	Password string
+	Host     string
+}
+
+// Update renames a user or changes the host it is allowed to connect from.
+func Update(c *gophercloud.ServiceClient, instanceID, userName string, opts UpdateOpts) UpdateResult {
+	var res UpdateResult
+
+	type user struct {
+		Name     string `json:"name,omitempty"`
+		Password string `json:"password,omitempty"`
+		Host     string `json:"host,omitempty"`
+	}
+
+	type request struct {
+		User user `json:"user"`
+	}
+
+	reqBody := request{User: user{
+		Name:     opts.Name,
+		Password: opts.Password,
+		Host:     opts.Host,
+	}}
+
+	_, res.Err = perigee.Request("PUT", userURL(c, instanceID, userName), perigee.Options{
+		MoreHeaders:  c.Provider.AuthenticatedHeaders(),
+		CustomClient: &c.Provider.HTTPClient,
+		ReqBody:      &reqBody,
+		OkCodes:      []int{202},
+	})
+
+	return res
+}
+
+// ListAccess will list all of the databases a particular user has access to
+// view or modify.
+func ListAccess(c *gophercloud.ServiceClient, instanceID, userName string) pagination.Pager {
+	createPageFn := func(r pagination.LastHTTPResponse) pagination.Page {
+		return AccessPage{pagination.SinglePageBase(r)}
+	}
+
+	return pagination.NewPager(c, dbsURL(c, instanceID, userName), createPageFn)
+}
+
+// GrantAccess grants a user permission to access one or more databases.
+func GrantAccess(c *gophercloud.ServiceClient, instanceID, userName string, dbs []string) GrantAccessResult {
+	var res GrantAccessResult
+
+	type request struct {
+		Databases []dbName `json:"databases"`
+	}
+
+	_, res.Err = perigee.Request("PUT", dbsURL(c, instanceID, userName), perigee.Options{
+		MoreHeaders:  c.Provider.AuthenticatedHeaders(),
+		CustomClient: &c.Provider.HTTPClient,
+		ReqBody:      &request{Databases: dbNames(dbs)},
+		OkCodes:      []int{202},
+	})
+
+	return res
+}
+
+// RevokeAccess removes a user's permission to access a database.
+func RevokeAccess(c *gophercloud.ServiceClient, instanceID, userName, dbName string) RevokeAccessResult {
+	var res RevokeAccessResult
+
+	_, res.Err = perigee.Request("DELETE", dbURL(c, instanceID, userName, dbName), perigee.Options{
+		MoreHeaders:  c.Provider.AuthenticatedHeaders(),
+		CustomClient: &c.Provider.HTTPClient,
+		OkCodes:      []int{202},
+	})
+
+	return res
+}
+
+type dbName struct {
+	Name string `json:"name"`
+}
+
+func dbNames(names []string) []dbName {
+	out := make([]dbName, len(names))
+	for i, n := range names {
+		out[i] = dbName{Name: n}
+	}
+	return out
+}
@@ -0,0 +1,142 @@
+package users
+
+import (
+	"testing"
+
+	"github.com/rackspace/gophercloud/pagination"
+	th "github.com/rackspace/gophercloud/testhelper"
+	fake "github.com/rackspace/gophercloud/testhelper/client"
+)
+
+const instanceID = "{instanceID}"
+
+func TestCreate(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	HandleCreateUsersSuccessfully(t, instanceID)
+
+	opts := BatchCreateOpts{
+		CreateOpts{Name: "testuser", Password: "password", Databases: []string{"testingdb"}},
+		CreateOpts{Name: "otheruser", Password: "password"},
+	}
+
+	res := Create(fake.ServiceClient(), instanceID, opts)
+	th.AssertNoErr(t, res.Err)
+}
+
+func TestList(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	HandleListUsersSuccessfully(t, instanceID)
+
+	expectedUsers := []User{
+		User{Name: "testuser", Host: "%"},
+	}
+
+	pages := 0
+	err := List(fake.ServiceClient(), instanceID).EachPage(func(page pagination.Page) (bool, error) {
+		pages++
+
+		actual, err := ExtractUsers(page)
+		if err != nil {
+			return false, err
+		}
+
+		th.CheckDeepEquals(t, expectedUsers[0].Name, actual[0].Name)
+
+		return true, nil
+	})
+
+	th.AssertNoErr(t, err)
+
+	if pages != 1 {
+		t.Errorf("Expected 1 page, saw %d", pages)
+	}
+}
+
+func TestDelete(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	HandleDeleteUserSuccessfully(t, instanceID, "testuser")
+
+	res := Delete(fake.ServiceClient(), instanceID, "testuser")
+	th.AssertNoErr(t, res.Err)
+}
+
+func TestGrantAccess(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	HandleGrantAccessSuccessfully(t, instanceID, "testuser")
+
+	res := GrantAccess(fake.ServiceClient(), instanceID, "testuser", []string{"testingdb"})
+	th.AssertNoErr(t, res.Err)
+}
+
+func TestUpdate(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	HandleUpdateUserSuccessfully(t, instanceID, "testuser")
+
+	opts := UpdateOpts{Name: "renameduser", Host: "%"}
+
+	res := Update(fake.ServiceClient(), instanceID, "testuser", opts)
+	th.AssertNoErr(t, res.Err)
+}
+
+func TestChangePassword(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	HandleChangePasswordSuccessfully(t, instanceID)
+
+	opts := UpdatePasswordsOpts{
+		UpdatePasswordOpts{Name: "testuser", Password: "newpassword"},
+	}
+
+	res := ChangePassword(fake.ServiceClient(), instanceID, opts)
+	th.AssertNoErr(t, res.Err)
+}
+
+func TestListAccess(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	HandleListAccessSuccessfully(t, instanceID, "testuser")
+
+	expectedDBs := []string{"testingdb"}
+
+	pages := 0
+	err := ListAccess(fake.ServiceClient(), instanceID, "testuser").EachPage(func(page pagination.Page) (bool, error) {
+		pages++
+
+		actual, err := ExtractDBNames(page)
+		if err != nil {
+			return false, err
+		}
+
+		th.CheckDeepEquals(t, expectedDBs, actual)
+
+		return true, nil
+	})
+
+	th.AssertNoErr(t, err)
+
+	if pages != 1 {
+		t.Errorf("Expected 1 page, saw %d", pages)
+	}
+}
+
+func TestRevokeAccess(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	HandleRevokeAccessSuccessfully(t, instanceID, "testuser", "testingdb")
+
+	res := RevokeAccess(fake.ServiceClient(), instanceID, "testuser", "testingdb")
+	th.AssertNoErr(t, res.Err)
+}
@@ -0,0 +1,104 @@
+package users
+
+import (
+	"github.com/mitchellh/mapstructure"
+	"github.com/rackspace/gophercloud"
+	"github.com/rackspace/gophercloud/pagination"
+)
+
+// User represents a database user, as returned by List.
+type User struct {
+	Name      string `mapstructure:"name"`
+	Host      string `mapstructure:"host"`
+	Databases []struct {
+		Name string `mapstructure:"name"`
+	} `mapstructure:"databases"`
+}
+
+// UserPage represents a single page of a paginated user collection.
+type UserPage struct {
+	pagination.SinglePageBase
+}
+
+// IsEmpty checks to see whether the collection is empty.
+func (page UserPage) IsEmpty() (bool, error) {
+	users, err := ExtractUsers(page)
+	return len(users) == 0, err
+}
+
+// ExtractUsers will convert a generic pagination struct into a more
+// relevant slice of User structs.
+func ExtractUsers(page pagination.Page) ([]User, error) {
+	casted := page.(UserPage).Body
+
+	var resp struct {
+		Users []User `mapstructure:"users"`
+	}
+
+	err := mapstructure.Decode(casted, &resp)
+
+	return resp.Users, err
+}
+
+// AccessPage represents a single page of the collection of databases a
+// given user is allowed to access.
+type AccessPage struct {
+	pagination.SinglePageBase
+}
+
+// IsEmpty checks to see whether the collection is empty.
+func (page AccessPage) IsEmpty() (bool, error) {
+	dbs, err := ExtractDBNames(page)
+	return len(dbs) == 0, err
+}
+
+// ExtractDBNames will convert a generic pagination struct into a slice of
+// database names a user has been granted access to.
+func ExtractDBNames(page pagination.Page) ([]string, error) {
+	casted := page.(AccessPage).Body
+
+	var resp struct {
+		Databases []struct {
+			Name string `mapstructure:"name"`
+		} `mapstructure:"databases"`
+	}
+
+	err := mapstructure.Decode(casted, &resp)
+
+	names := make([]string, len(resp.Databases))
+	for i, db := range resp.Databases {
+		names[i] = db.Name
+	}
+
+	return names, err
+}
+
+// CreateResult represents the result of a Create operation.
+type CreateResult struct {
+	gophercloud.ErrResult
+}
+
+// DeleteResult represents the result of a Delete operation.
+type DeleteResult struct {
+	gophercloud.ErrResult
+}
+
+// ChangePasswordResult represents the result of a ChangePassword operation.
+type ChangePasswordResult struct {
+	gophercloud.ErrResult
+}
+
+// UpdateResult represents the result of an Update operation.
+type UpdateResult struct {
+	gophercloud.ErrResult
+}
+
+// GrantAccessResult represents the result of a GrantAccess operation.
+type GrantAccessResult struct {
+	gophercloud.ErrResult
+}
+
+// RevokeAccessResult represents the result of a RevokeAccess operation.
+type RevokeAccessResult struct {
+	gophercloud.ErrResult
+}
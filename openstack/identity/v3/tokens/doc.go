@@ -0,0 +1,10 @@
+// Package tokens provides information and interaction with the Keystone v3
+// API for OpenStack.
+//
+// Unlike v2, a v3 authentication request is built from an "identity" (how
+// the caller proves who they are: password or token) and a "scope" (what
+// project or domain the resulting token is valid for). This package turns
+// a gophercloud.AuthOptions value into that nested request body and parses
+// the resulting token's expiry and scope back out of the response; the
+// service catalog in the response is not decoded.
+package tokens
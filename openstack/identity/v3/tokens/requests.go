@@ -0,0 +1,136 @@
+package tokens
+
+import (
+	"fmt"
+
+	"github.com/racker/perigee"
+	"github.com/rackspace/gophercloud"
+)
+
+var errUsernameRequired = fmt.Errorf("Username is required")
+
+// authRequest is the outer envelope Keystone v3 expects: an "auth" object
+// holding the "identity" (how the caller proves who they are) and the
+// optional "scope" (what the resulting token is valid for).
+type authRequest struct {
+	Auth struct {
+		Identity identityReq  `json:"identity"`
+		Scope    *scopeReq    `json:"scope,omitempty"`
+	} `json:"auth"`
+}
+
+type identityReq struct {
+	Methods  []string      `json:"methods"`
+	Password *passwordReq  `json:"password,omitempty"`
+	Token    *tokenReq     `json:"token,omitempty"`
+}
+
+type passwordReq struct {
+	User userReq `json:"user"`
+}
+
+type tokenReq struct {
+	ID string `json:"id"`
+}
+
+type userReq struct {
+	ID       string     `json:"id,omitempty"`
+	Name     string     `json:"name,omitempty"`
+	Password string     `json:"password"`
+	Domain   *domainReq `json:"domain,omitempty"`
+}
+
+type domainReq struct {
+	ID   string `json:"id,omitempty"`
+	Name string `json:"name,omitempty"`
+}
+
+type projectReq struct {
+	ID     string     `json:"id,omitempty"`
+	Name   string     `json:"name,omitempty"`
+	Domain *domainReq `json:"domain,omitempty"`
+}
+
+type scopeReq struct {
+	Project *projectReq `json:"project,omitempty"`
+	Domain  *domainReq  `json:"domain,omitempty"`
+}
+
+// Create authenticates against the Keystone v3 identity API at endpoint and
+// returns a CreateResult holding the issued token and its catalog.
+//
+// Precisely one of opts.TokenID or opts.Username must be set: a TokenID
+// reauthenticates an existing token, while a Username authenticates with a
+// password. When Username is set, opts.DomainID or opts.DomainName must
+// identify the domain the user belongs to, since v3 usernames are only
+// unique within a domain.
+//
+// The returned token is scoped to opts.TenantID/opts.TenantName (a project
+// scope) if set, else to opts.DomainID/opts.DomainName (a domain scope) if
+// set, else it is issued unscoped.
+func Create(client *gophercloud.ProviderClient, endpoint string, opts gophercloud.AuthOptions) CreateResult {
+	var res CreateResult
+
+	var req authRequest
+
+	switch {
+	case opts.TokenID != "":
+		req.Auth.Identity.Methods = []string{"token"}
+		req.Auth.Identity.Token = &tokenReq{ID: opts.TokenID}
+	case opts.Username != "":
+		if opts.DomainID == "" && opts.DomainName == "" {
+			res.Err = fmt.Errorf("DomainID or DomainName is required when authenticating by Username against Keystone v3")
+			return res
+		}
+
+		req.Auth.Identity.Methods = []string{"password"}
+		req.Auth.Identity.Password = &passwordReq{User: userReq{
+			Name:     opts.Username,
+			Password: opts.Password,
+			Domain:   userDomain(opts),
+		}}
+	default:
+		res.Err = errUsernameRequired
+		return res
+	}
+
+	if scope := authScope(opts); scope != nil {
+		req.Auth.Scope = scope
+	}
+
+	resp, err := perigee.Request("POST", endpoint, perigee.Options{
+		CustomClient: &client.HTTPClient,
+		ReqBody:      &req,
+		Results:      &res.Resp,
+		OkCodes:      []int{200, 201},
+	})
+	res.Err = err
+	if err == nil {
+		res.Header = resp.HttpResponse.Header
+	}
+
+	return res
+}
+
+func userDomain(opts gophercloud.AuthOptions) *domainReq {
+	if opts.DomainID == "" && opts.DomainName == "" {
+		return nil
+	}
+	return &domainReq{ID: opts.DomainID, Name: opts.DomainName}
+}
+
+func authScope(opts gophercloud.AuthOptions) *scopeReq {
+	if opts.TenantID != "" || opts.TenantName != "" {
+		return &scopeReq{Project: &projectReq{
+			ID:     opts.TenantID,
+			Name:   opts.TenantName,
+			Domain: userDomain(opts),
+		}}
+	}
+
+	if opts.DomainID != "" || opts.DomainName != "" {
+		return &scopeReq{Domain: &domainReq{ID: opts.DomainID, Name: opts.DomainName}}
+	}
+
+	return nil
+}
@@ -0,0 +1,69 @@
+package tokens
+
+import (
+	"net/http"
+
+	"github.com/mitchellh/mapstructure"
+	"github.com/rackspace/gophercloud"
+)
+
+// Domain is the domain a token is scoped to, when it is scoped to a domain
+// rather than a project.
+type Domain struct {
+	ID   string `mapstructure:"id"`
+	Name string `mapstructure:"name"`
+}
+
+// Project is the project a token is scoped to, when it is scoped to a
+// project rather than a domain.
+type Project struct {
+	ID     string `mapstructure:"id"`
+	Name   string `mapstructure:"name"`
+	Domain Domain `mapstructure:"domain"`
+}
+
+// Token is the result of a successful Keystone v3 authentication. Unlike
+// v2, the token's own ID is not part of the response body: it is returned
+// in the X-Subject-Token response header instead, which CreateResult.
+// ExtractTokenID reads on its behalf.
+type Token struct {
+	ExpiresAt string  `mapstructure:"expires_at"`
+	IssuedAt  string  `mapstructure:"issued_at"`
+	Project   Project `mapstructure:"project"`
+	Domain    Domain  `mapstructure:"domain"`
+}
+
+// CreateResult represents the result of a Create operation.
+type CreateResult struct {
+	gophercloud.Result
+
+	// Header is the raw HTTP response header, kept around so ExtractTokenID
+	// can pull X-Subject-Token out of it; the token ID is never part of the
+	// JSON body Extract decodes.
+	Header http.Header
+}
+
+// Extract interprets a CreateResult as a Token.
+func (r CreateResult) Extract() (*Token, error) {
+	if r.Err != nil {
+		return nil, r.Err
+	}
+
+	var res struct {
+		Token Token `mapstructure:"token"`
+	}
+
+	err := mapstructure.Decode(r.Resp, &res)
+
+	return &res.Token, err
+}
+
+// ExtractTokenID returns the ID of the token this CreateResult represents,
+// read from the X-Subject-Token response header Keystone v3 carries it in.
+func (r CreateResult) ExtractTokenID() (string, error) {
+	if r.Err != nil {
+		return "", r.Err
+	}
+
+	return r.Header.Get("X-Subject-Token"), nil
+}
@@ -0,0 +1,6 @@
+// Package routers provides information and interaction with the routers API
+// resource for the OpenStack Neutron layer-3 extension.
+//
+// A router interconnects subnets and, depending on the cloud's agent
+// configuration, provides a gateway to external networks.
+package routers
@@ -0,0 +1,225 @@
+package routers
+
+import (
+	"strconv"
+
+	"github.com/racker/perigee"
+	"github.com/rackspace/gophercloud"
+	"github.com/rackspace/gophercloud/openstack/utils"
+	"github.com/rackspace/gophercloud/pagination"
+)
+
+// ListOpts allows the filtering and sorting of paginated collections through
+// the API. Filtering is achieved by passing in struct field values that map to
+// the router attributes you want to see returned.
+type ListOpts struct {
+	ID                  string
+	Name                string
+	AdminStateUp        *bool
+	Distributed         *bool
+	HA                  *bool
+	Status              string
+	TenantID            string
+	GatewayNetworkID    string
+	Limit               int
+	Marker              string
+	SortKey             string
+	SortDir             string
+}
+
+// List returns a Pager which allows you to iterate over a collection of
+// routers. It accepts a ListOpts struct, which allows you to filter and sort
+// the returned collection for greater efficiency.
+func List(c *gophercloud.ServiceClient, opts ListOpts) pagination.Pager {
+	q := make(map[string]string)
+	if opts.ID != "" {
+		q["id"] = opts.ID
+	}
+	if opts.Name != "" {
+		q["name"] = opts.Name
+	}
+	if opts.AdminStateUp != nil {
+		q["admin_state_up"] = strconv.FormatBool(*opts.AdminStateUp)
+	}
+	if opts.Distributed != nil {
+		q["distributed"] = strconv.FormatBool(*opts.Distributed)
+	}
+	if opts.HA != nil {
+		q["ha"] = strconv.FormatBool(*opts.HA)
+	}
+	if opts.Status != "" {
+		q["status"] = opts.Status
+	}
+	if opts.TenantID != "" {
+		q["tenant_id"] = opts.TenantID
+	}
+	if opts.GatewayNetworkID != "" {
+		q["external_gateway_info.network_id"] = opts.GatewayNetworkID
+	}
+	if opts.Marker != "" {
+		q["marker"] = opts.Marker
+	}
+	if opts.Limit != 0 {
+		q["limit"] = strconv.Itoa(opts.Limit)
+	}
+	if opts.SortKey != "" {
+		q["sort_key"] = opts.SortKey
+	}
+	if opts.SortDir != "" {
+		q["sort_dir"] = opts.SortDir
+	}
+
+	u := rootURL(c) + utils.BuildQuery(q)
+
+	return pagination.NewPager(c, u, func(r pagination.LastHTTPResponse) pagination.Page {
+		return RouterPage{pagination.LinkedPageBase{LastHTTPResponse: r}}
+	})
+}
+
+// GatewayInfo represents the information of an external gateway for any
+// routers configured to use it.
+type GatewayInfo struct {
+	NetworkID string `json:"network_id"`
+}
+
+// CreateOpts contains all the values needed to create a new router.
+type CreateOpts struct {
+	// Optional. Human-readable name for the router. Does not have to be
+	// unique.
+	Name string
+
+	// Required for admins. Indicates the owner of the router.
+	TenantID string
+
+	// Optional. The administrative state of the router. A valid value is
+	// true (UP) or false (DOWN).
+	AdminStateUp *bool
+
+	// Optional. Indicates whether this is a distributed (DVR) router,
+	// spreading the router's namespace across multiple network nodes
+	// instead of hosting it on a single L3 agent.
+	Distributed *bool
+
+	// Optional. Indicates whether this router is configured for highly
+	// available (VRRP-backed) operation across multiple L3 agents.
+	HA *bool
+
+	// Optional. The network on which to create an external gateway.
+	GatewayInfo *GatewayInfo
+}
+
+// Create is an operation which provisions a new router based on the
+// configuration defined in the CreateOpts struct. Once the request is
+// validated and progress has started on the provisioning process, a
+// CreateResult will be returned.
+//
+// Users with an admin role can create routers on behalf of other tenants by
+// specifying a TenantID attribute different than their own.
+func Create(c *gophercloud.ServiceClient, opts CreateOpts) CreateResult {
+	var res CreateResult
+
+	type router struct {
+		Name         *string      `json:"name,omitempty"`
+		TenantID     *string      `json:"tenant_id,omitempty"`
+		AdminStateUp *bool        `json:"admin_state_up,omitempty"`
+		Distributed  *bool        `json:"distributed,omitempty"`
+		HA           *bool        `json:"ha,omitempty"`
+		GatewayInfo  *GatewayInfo `json:"external_gateway_info,omitempty"`
+	}
+
+	type request struct {
+		Router router `json:"router"`
+	}
+
+	reqBody := request{Router: router{
+		Name:         gophercloud.MaybeString(opts.Name),
+		TenantID:     gophercloud.MaybeString(opts.TenantID),
+		AdminStateUp: opts.AdminStateUp,
+		Distributed:  opts.Distributed,
+		HA:           opts.HA,
+		GatewayInfo:  opts.GatewayInfo,
+	}}
+
+	_, res.Err = perigee.Request("POST", rootURL(c), perigee.Options{
+		MoreHeaders:  c.Provider.AuthenticatedHeaders(),
+		CustomClient: &c.Provider.HTTPClient,
+		ReqBody:      &reqBody,
+		Results:      &res.Resp,
+		OkCodes:      []int{201},
+	})
+
+	return res
+}
+
+// Get retrieves a particular router based on its unique ID.
+func Get(c *gophercloud.ServiceClient, id string) GetResult {
+	var res GetResult
+
+	_, res.Err = perigee.Request("GET", resourceURL(c, id), perigee.Options{
+		MoreHeaders:  c.Provider.AuthenticatedHeaders(),
+		CustomClient: &c.Provider.HTTPClient,
+		Results:      &res.Resp,
+		OkCodes:      []int{200},
+	})
+
+	return res
+}
+
+// UpdateOpts contains the values used when updating a router.
+type UpdateOpts struct {
+	Name         string
+	AdminStateUp *bool
+	Distributed  *bool
+	HA           *bool
+	GatewayInfo  *GatewayInfo
+}
+
+// Update is an operation which modifies the attributes of the specified
+// router.
+func Update(c *gophercloud.ServiceClient, id string, opts UpdateOpts) UpdateResult {
+	var res UpdateResult
+
+	type router struct {
+		Name         *string      `json:"name,omitempty"`
+		AdminStateUp *bool        `json:"admin_state_up,omitempty"`
+		Distributed  *bool        `json:"distributed,omitempty"`
+		HA           *bool        `json:"ha,omitempty"`
+		GatewayInfo  *GatewayInfo `json:"external_gateway_info,omitempty"`
+	}
+
+	type request struct {
+		Router router `json:"router"`
+	}
+
+	reqBody := request{Router: router{
+		Name:         gophercloud.MaybeString(opts.Name),
+		AdminStateUp: opts.AdminStateUp,
+		Distributed:  opts.Distributed,
+		HA:           opts.HA,
+		GatewayInfo:  opts.GatewayInfo,
+	}}
+
+	_, res.Err = perigee.Request("PUT", resourceURL(c, id), perigee.Options{
+		MoreHeaders:  c.Provider.AuthenticatedHeaders(),
+		CustomClient: &c.Provider.HTTPClient,
+		ReqBody:      &reqBody,
+		Results:      &res.Resp,
+		OkCodes:      []int{200},
+	})
+
+	return res
+}
+
+// Delete will permanently delete a particular router based on its unique
+// ID.
+func Delete(c *gophercloud.ServiceClient, id string) DeleteResult {
+	var res DeleteResult
+
+	_, res.Err = perigee.Request("DELETE", resourceURL(c, id), perigee.Options{
+		MoreHeaders:  c.Provider.AuthenticatedHeaders(),
+		CustomClient: &c.Provider.HTTPClient,
+		OkCodes:      []int{204},
+	})
+
+	return res
+}
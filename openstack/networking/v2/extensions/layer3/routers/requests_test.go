@@ -0,0 +1,81 @@
+package routers
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	th "github.com/rackspace/gophercloud/testhelper"
+	fake "github.com/rackspace/gophercloud/testhelper/client"
+)
+
+func TestCreateDistributed(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/routers", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestJSONRequest(t, r, `
+{
+	"router": {
+		"name": "dvr1",
+		"distributed": true
+	}
+}
+	`)
+
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprintf(w, `{"router": {"id": "1", "name": "dvr1", "distributed": true}}`)
+	})
+
+	distributed := true
+	_, err := Create(fake.ServiceClient(), CreateOpts{Name: "dvr1", Distributed: &distributed}).Extract()
+	th.AssertNoErr(t, err)
+}
+
+func TestCreateWithoutDistributedOmitsField(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/routers", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "POST")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestJSONRequest(t, r, `
+{
+	"router": {
+		"name": "router1"
+	}
+}
+	`)
+
+		w.WriteHeader(http.StatusCreated)
+		fmt.Fprintf(w, `{"router": {"id": "1", "name": "router1"}}`)
+	})
+
+	_, err := Create(fake.ServiceClient(), CreateOpts{Name: "router1"}).Extract()
+	th.AssertNoErr(t, err)
+}
+
+func TestUpdateHA(t *testing.T) {
+	th.SetupHTTP()
+	defer th.TeardownHTTP()
+
+	th.Mux.HandleFunc("/routers/1", func(w http.ResponseWriter, r *http.Request) {
+		th.TestMethod(t, r, "PUT")
+		th.TestHeader(t, r, "X-Auth-Token", fake.TokenID)
+		th.TestJSONRequest(t, r, `
+{
+	"router": {
+		"ha": true
+	}
+}
+	`)
+
+		fmt.Fprintf(w, `{"router": {"id": "1", "ha": true}}`)
+	})
+
+	ha := true
+	_, err := Update(fake.ServiceClient(), "1", UpdateOpts{HA: &ha}).Extract()
+	th.AssertNoErr(t, err)
+}
@@ -218,11 +218,112 @@ func Create(c *gophercloud.ServiceClient, opts CreateOpts) CreateResult {
 	}
 
 	_, res.Err = perigee.Request("POST", rootURL(c), perigee.Options{
-		MoreHeaders: c.Provider.AuthenticatedHeaders(),
-		ReqBody:     &reqBody,
-		Results:     &res.Resp,
-		OkCodes:     []int{201},
+		MoreHeaders:  c.Provider.AuthenticatedHeaders(),
+		CustomClient: &c.Provider.HTTPClient,
+		ReqBody:      &reqBody,
+		Results:      &res.Resp,
+		OkCodes:      []int{201},
 	})
 
 	return res
 }
+
+// Get retrieves a particular virtual IP based on its unique ID.
+func Get(c *gophercloud.ServiceClient, id string) GetResult {
+	var res GetResult
+
+	_, res.Err = perigee.Request("GET", resourceURL(c, id), perigee.Options{
+		MoreHeaders:  c.Provider.AuthenticatedHeaders(),
+		CustomClient: &c.Provider.HTTPClient,
+		Results:      &res.Resp,
+		OkCodes:      []int{200},
+	})
+
+	return res
+}
+
+// UpdateOpts contains the values used when updating a virtual IP.
+type UpdateOpts struct {
+	// Optional. Human-readable name for the VIP. Does not have to be unique.
+	Name string
+
+	// Optional. The ID of the pool with which the VIP is associated.
+	PoolID string
+
+	// Optional. Human-readable description for the VIP.
+	Description string
+
+	// Optional. The maximum number of connections allowed for the VIP.
+	ConnLimit *int
+
+	// Optional. The administrative state of the VIP. A valid value is true (UP)
+	// or false (DOWN).
+	AdminStateUp *bool
+
+	// Optional. Omit this field to leave session persistence unchanged. Pass
+	// an empty &SessionPersistence{} to clear it.
+	Persistence *SessionPersistence
+}
+
+// Update is an operation which modifies the attributes of the specified VIP.
+func Update(c *gophercloud.ServiceClient, id string, opts UpdateOpts) UpdateResult {
+	var res UpdateResult
+
+	type vip struct {
+		Name         *string             `json:"name,omitempty"`
+		PoolID       *string             `json:"pool_id,omitempty"`
+		Description  *string             `json:"description,omitempty"`
+		ConnLimit    *int                `json:"connection_limit,omitempty"`
+		AdminStateUp *bool               `json:"admin_state_up,omitempty"`
+		Persistence  *SessionPersistence `json:"session_persistence,omitempty"`
+	}
+
+	type request struct {
+		VirtualIP vip `json:"vip"`
+	}
+
+	reqBody := request{VirtualIP: vip{
+		Name:         gophercloud.MaybeString(opts.Name),
+		PoolID:       gophercloud.MaybeString(opts.PoolID),
+		Description:  gophercloud.MaybeString(opts.Description),
+		ConnLimit:    opts.ConnLimit,
+		AdminStateUp: opts.AdminStateUp,
+		Persistence:  opts.Persistence,
+	}}
+
+	_, res.Err = perigee.Request("PUT", resourceURL(c, id), perigee.Options{
+		MoreHeaders:  c.Provider.AuthenticatedHeaders(),
+		CustomClient: &c.Provider.HTTPClient,
+		ReqBody:      &reqBody,
+		Results:      &res.Resp,
+		OkCodes:      []int{200},
+	})
+
+	return res
+}
+
+// WaitForStatus polls the VIP with the given id until its Status field
+// matches the requested status, returning early on error or after secs
+// seconds have elapsed without a match.
+//
+// A VIP which transitions to a Status of ERROR is considered terminal and
+// stops the poll immediately, since this state machine never recovers on
+// its own.
+func WaitForStatus(c *gophercloud.ServiceClient, id, status string, secs int) error {
+	return gophercloud.WaitFor(secs, func() (bool, error) {
+		current, err := Get(c, id).Extract()
+		if err != nil {
+			return false, err
+		}
+
+		if current.Status == status {
+			return true, nil
+		}
+
+		if current.Status == "ERROR" {
+			return false, fmt.Errorf("VIP %s entered ERROR state while waiting for %s", id, status)
+		}
+
+		return false, nil
+	})
+}
@@ -0,0 +1,62 @@
+package vips
+
+import (
+	"github.com/mitchellh/mapstructure"
+	"github.com/rackspace/gophercloud"
+)
+
+// SessionPersistence represents the session persistence feature of the
+// load balancing service. It attempts to force connections or requests
+// from the same end user to be processed by the same member as long as it
+// is active.
+type SessionPersistence struct {
+	Type       string `json:"type,omitempty" mapstructure:"type"`
+	CookieName string `json:"cookie_name,omitempty" mapstructure:"cookie_name"`
+}
+
+// VIP is an internal representation of a virtual IP.
+type VIP struct {
+	ID           string              `mapstructure:"id"`
+	Name         string              `mapstructure:"name"`
+	Description  string              `mapstructure:"description"`
+	SubnetID     string              `mapstructure:"subnet_id"`
+	Address      string              `mapstructure:"address"`
+	PortID       string              `mapstructure:"port_id"`
+	Protocol     string              `mapstructure:"protocol"`
+	ProtocolPort int                 `mapstructure:"protocol_port"`
+	PoolID       string              `mapstructure:"pool_id"`
+	TenantID     string              `mapstructure:"tenant_id"`
+	ConnLimit    int                 `mapstructure:"connection_limit"`
+	AdminStateUp bool                `mapstructure:"admin_state_up"`
+	Status       string              `mapstructure:"status"`
+	Persistence  SessionPersistence  `mapstructure:"session_persistence"`
+}
+
+type commonResult struct {
+	gophercloud.Result
+}
+
+// Extract is a function that accepts a result and extracts a virtual IP.
+func (r commonResult) Extract() (*VIP, error) {
+	if r.Err != nil {
+		return nil, r.Err
+	}
+
+	var res struct {
+		VirtualIP VIP `mapstructure:"vip"`
+	}
+
+	err := mapstructure.Decode(r.Resp, &res)
+
+	return &res.VirtualIP, err
+}
+
+// GetResult represents the result of a get operation.
+type GetResult struct {
+	commonResult
+}
+
+// UpdateResult represents the result of an update operation.
+type UpdateResult struct {
+	commonResult
+}
@@ -0,0 +1,8 @@
+// Package l7policies provides information and interaction with the L7
+// policies and rules API resources for the OpenStack Neutron LBaaS v2
+// extension.
+//
+// An L7 policy is attached to a listener and evaluates a set of L7 rules,
+// such as matching a request path or header, to decide whether to redirect
+// the request to a different pool, URL, or reject it outright.
+package l7policies
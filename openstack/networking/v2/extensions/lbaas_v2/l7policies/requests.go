@@ -0,0 +1,404 @@
+package l7policies
+
+import (
+	"fmt"
+
+	"github.com/racker/perigee"
+	"github.com/rackspace/gophercloud"
+	"github.com/rackspace/gophercloud/openstack/utils"
+	"github.com/rackspace/gophercloud/pagination"
+)
+
+// ListOpts allows the filtering and sorting of paginated collections through
+// the API. Filtering is achieved by passing in struct field values that map to
+// the L7 policy attributes you want to see returned.
+type ListOpts struct {
+	ID          string
+	Name        string
+	ListenerID  string
+	Action      string
+	TenantID    string
+	Position    int
+	Limit       int
+	Marker      string
+	SortKey     string
+	SortDir     string
+}
+
+// List returns a Pager which allows you to iterate over a collection of
+// L7 policies. It accepts a ListOpts struct, which allows you to filter
+// and sort the returned collection for greater efficiency.
+func List(c *gophercloud.ServiceClient, opts ListOpts) pagination.Pager {
+	q := make(map[string]string)
+	if opts.ID != "" {
+		q["id"] = opts.ID
+	}
+	if opts.Name != "" {
+		q["name"] = opts.Name
+	}
+	if opts.ListenerID != "" {
+		q["listener_id"] = opts.ListenerID
+	}
+	if opts.Action != "" {
+		q["action"] = opts.Action
+	}
+	if opts.TenantID != "" {
+		q["tenant_id"] = opts.TenantID
+	}
+	if opts.Position != 0 {
+		q["position"] = fmt.Sprintf("%d", opts.Position)
+	}
+	if opts.Marker != "" {
+		q["marker"] = opts.Marker
+	}
+	if opts.Limit != 0 {
+		q["limit"] = fmt.Sprintf("%d", opts.Limit)
+	}
+	if opts.SortKey != "" {
+		q["sort_key"] = opts.SortKey
+	}
+	if opts.SortDir != "" {
+		q["sort_dir"] = opts.SortDir
+	}
+
+	u := rootURL(c) + utils.BuildQuery(q)
+
+	return pagination.NewPager(c, u, func(r pagination.LastHTTPResponse) pagination.Page {
+		return L7PolicyPage{pagination.LinkedPageBase{LastHTTPResponse: r}}
+	})
+}
+
+// CreateOpts contains all the values needed to create a new L7 policy.
+type CreateOpts struct {
+	// Required. The listener on which the policy is applied.
+	ListenerID string
+
+	// Required. The action the policy takes when its rules match, one of
+	// REDIRECT_TO_POOL, REDIRECT_TO_URL, or REJECT.
+	Action string
+
+	// Optional. Human-readable name for the policy.
+	Name string
+
+	// Optional. Required when Action is REDIRECT_TO_POOL. The pool to which
+	// matching requests are redirected.
+	RedirectPoolID string
+
+	// Optional. Required when Action is REDIRECT_TO_URL. The URL to which
+	// matching requests are redirected.
+	RedirectURL string
+
+	// Optional. The position of this policy in the listener's policy list;
+	// lower numbers are evaluated first.
+	Position int
+
+	// Required for admins. Indicates the owner of the policy.
+	TenantID string
+
+	// Optional. The administrative state of the policy. A valid value is
+	// true (UP) or false (DOWN).
+	AdminStateUp *bool
+}
+
+var (
+	errListenerIDRequiredL7 = fmt.Errorf("ListenerID is required")
+	errActionRequired       = fmt.Errorf("Action is required")
+)
+
+// Create is an operation which provisions a new L7 policy based on the
+// configuration defined in the CreateOpts struct.
+func Create(c *gophercloud.ServiceClient, opts CreateOpts) CreateResult {
+	var res CreateResult
+
+	if opts.ListenerID == "" {
+		res.Err = errListenerIDRequiredL7
+		return res
+	}
+	if opts.Action == "" {
+		res.Err = errActionRequired
+		return res
+	}
+
+	type policy struct {
+		ListenerID     string  `json:"listener_id"`
+		Action         string  `json:"action"`
+		Name           *string `json:"name,omitempty"`
+		RedirectPoolID *string `json:"redirect_pool_id,omitempty"`
+		RedirectURL    *string `json:"redirect_url,omitempty"`
+		Position       int     `json:"position,omitempty"`
+		TenantID       *string `json:"tenant_id,omitempty"`
+		AdminStateUp   *bool   `json:"admin_state_up,omitempty"`
+	}
+
+	type request struct {
+		Policy policy `json:"l7policy"`
+	}
+
+	reqBody := request{Policy: policy{
+		ListenerID:     opts.ListenerID,
+		Action:         opts.Action,
+		Name:           gophercloud.MaybeString(opts.Name),
+		RedirectPoolID: gophercloud.MaybeString(opts.RedirectPoolID),
+		RedirectURL:    gophercloud.MaybeString(opts.RedirectURL),
+		Position:       opts.Position,
+		TenantID:       gophercloud.MaybeString(opts.TenantID),
+		AdminStateUp:   opts.AdminStateUp,
+	}}
+
+	_, res.Err = perigee.Request("POST", rootURL(c), perigee.Options{
+		MoreHeaders:  c.Provider.AuthenticatedHeaders(),
+		CustomClient: &c.Provider.HTTPClient,
+		ReqBody:      &reqBody,
+		Results:      &res.Resp,
+		OkCodes:      []int{201},
+	})
+
+	return res
+}
+
+// Get retrieves a particular L7 policy based on its unique ID.
+func Get(c *gophercloud.ServiceClient, id string) GetResult {
+	var res GetResult
+
+	_, res.Err = perigee.Request("GET", resourceURL(c, id), perigee.Options{
+		MoreHeaders:  c.Provider.AuthenticatedHeaders(),
+		CustomClient: &c.Provider.HTTPClient,
+		Results:      &res.Resp,
+		OkCodes:      []int{200},
+	})
+
+	return res
+}
+
+// UpdateOpts contains the values used when updating an L7 policy.
+type UpdateOpts struct {
+	Name           string
+	RedirectPoolID string
+	RedirectURL    string
+	Position       int
+	AdminStateUp   *bool
+}
+
+// Update is an operation which modifies the attributes of the specified
+// L7 policy.
+func Update(c *gophercloud.ServiceClient, id string, opts UpdateOpts) UpdateResult {
+	var res UpdateResult
+
+	type policy struct {
+		Name           *string `json:"name,omitempty"`
+		RedirectPoolID *string `json:"redirect_pool_id,omitempty"`
+		RedirectURL    *string `json:"redirect_url,omitempty"`
+		Position       int     `json:"position,omitempty"`
+		AdminStateUp   *bool   `json:"admin_state_up,omitempty"`
+	}
+
+	type request struct {
+		Policy policy `json:"l7policy"`
+	}
+
+	reqBody := request{Policy: policy{
+		Name:           gophercloud.MaybeString(opts.Name),
+		RedirectPoolID: gophercloud.MaybeString(opts.RedirectPoolID),
+		RedirectURL:    gophercloud.MaybeString(opts.RedirectURL),
+		Position:       opts.Position,
+		AdminStateUp:   opts.AdminStateUp,
+	}}
+
+	_, res.Err = perigee.Request("PUT", resourceURL(c, id), perigee.Options{
+		MoreHeaders:  c.Provider.AuthenticatedHeaders(),
+		CustomClient: &c.Provider.HTTPClient,
+		ReqBody:      &reqBody,
+		Results:      &res.Resp,
+		OkCodes:      []int{200},
+	})
+
+	return res
+}
+
+// Delete will permanently delete a particular L7 policy based on its
+// unique ID. Its rules are deleted along with it.
+func Delete(c *gophercloud.ServiceClient, id string) DeleteResult {
+	var res DeleteResult
+
+	_, res.Err = perigee.Request("DELETE", resourceURL(c, id), perigee.Options{
+		MoreHeaders:  c.Provider.AuthenticatedHeaders(),
+		CustomClient: &c.Provider.HTTPClient,
+		OkCodes:      []int{204},
+	})
+
+	return res
+}
+
+// CreateRuleOpts contains all the values needed to create a new L7 rule
+// under a policy.
+type CreateRuleOpts struct {
+	// Required. The type of comparison to perform, one of HOST_NAME,
+	// PATH, FILE_TYPE, HEADER, or COOKIE.
+	Type string
+
+	// Required. The comparison operator to apply, one of REGEX, EQUAL_TO,
+	// STARTS_WITH, ENDS_WITH, or CONTAINS.
+	CompareType string
+
+	// Required. The value to match against.
+	Value string
+
+	// Optional. Required when Type is HEADER or COOKIE. The name of the
+	// header or cookie to inspect.
+	Key string
+
+	// Optional. Inverts the result of the comparison.
+	Invert bool
+
+	// Required for admins. Indicates the owner of the rule.
+	TenantID string
+
+	// Optional. The administrative state of the rule. A valid value is true
+	// (UP) or false (DOWN).
+	AdminStateUp *bool
+}
+
+var (
+	errRuleTypeRequired   = fmt.Errorf("Type is required")
+	errCompareTypeRequired = fmt.Errorf("CompareType is required")
+	errValueRequired      = fmt.Errorf("Value is required")
+)
+
+// CreateRule is an operation which provisions a new L7 rule under the given
+// policy based on the configuration defined in the CreateRuleOpts struct.
+func CreateRule(c *gophercloud.ServiceClient, policyID string, opts CreateRuleOpts) CreateRuleResult {
+	var res CreateRuleResult
+
+	if opts.Type == "" {
+		res.Err = errRuleTypeRequired
+		return res
+	}
+	if opts.CompareType == "" {
+		res.Err = errCompareTypeRequired
+		return res
+	}
+	if opts.Value == "" {
+		res.Err = errValueRequired
+		return res
+	}
+
+	type rule struct {
+		Type         string  `json:"type"`
+		CompareType  string  `json:"compare_type"`
+		Value        string  `json:"value"`
+		Key          *string `json:"key,omitempty"`
+		Invert       bool    `json:"invert,omitempty"`
+		TenantID     *string `json:"tenant_id,omitempty"`
+		AdminStateUp *bool   `json:"admin_state_up,omitempty"`
+	}
+
+	type request struct {
+		Rule rule `json:"rule"`
+	}
+
+	reqBody := request{Rule: rule{
+		Type:         opts.Type,
+		CompareType:  opts.CompareType,
+		Value:        opts.Value,
+		Key:          gophercloud.MaybeString(opts.Key),
+		Invert:       opts.Invert,
+		TenantID:     gophercloud.MaybeString(opts.TenantID),
+		AdminStateUp: opts.AdminStateUp,
+	}}
+
+	_, res.Err = perigee.Request("POST", ruleRootURL(c, policyID), perigee.Options{
+		MoreHeaders:  c.Provider.AuthenticatedHeaders(),
+		CustomClient: &c.Provider.HTTPClient,
+		ReqBody:      &reqBody,
+		Results:      &res.Resp,
+		OkCodes:      []int{201},
+	})
+
+	return res
+}
+
+// ListRules returns a Pager which allows you to iterate over the rules
+// belonging to the given policy.
+func ListRules(c *gophercloud.ServiceClient, policyID string) pagination.Pager {
+	u := ruleRootURL(c, policyID)
+
+	return pagination.NewPager(c, u, func(r pagination.LastHTTPResponse) pagination.Page {
+		return L7RulePage{pagination.LinkedPageBase{LastHTTPResponse: r}}
+	})
+}
+
+// GetRule retrieves a particular L7 rule belonging to the given policy.
+func GetRule(c *gophercloud.ServiceClient, policyID, ruleID string) GetRuleResult {
+	var res GetRuleResult
+
+	_, res.Err = perigee.Request("GET", ruleResourceURL(c, policyID, ruleID), perigee.Options{
+		MoreHeaders:  c.Provider.AuthenticatedHeaders(),
+		CustomClient: &c.Provider.HTTPClient,
+		Results:      &res.Resp,
+		OkCodes:      []int{200},
+	})
+
+	return res
+}
+
+// UpdateRuleOpts contains the values used when updating an L7 rule.
+type UpdateRuleOpts struct {
+	Type         string
+	CompareType  string
+	Value        string
+	Key          string
+	Invert       bool
+	AdminStateUp *bool
+}
+
+// UpdateRule is an operation which modifies the attributes of the
+// specified L7 rule.
+func UpdateRule(c *gophercloud.ServiceClient, policyID, ruleID string, opts UpdateRuleOpts) UpdateRuleResult {
+	var res UpdateRuleResult
+
+	type rule struct {
+		Type         *string `json:"type,omitempty"`
+		CompareType  *string `json:"compare_type,omitempty"`
+		Value        *string `json:"value,omitempty"`
+		Key          *string `json:"key,omitempty"`
+		Invert       bool    `json:"invert,omitempty"`
+		AdminStateUp *bool   `json:"admin_state_up,omitempty"`
+	}
+
+	type request struct {
+		Rule rule `json:"rule"`
+	}
+
+	reqBody := request{Rule: rule{
+		Type:         gophercloud.MaybeString(opts.Type),
+		CompareType:  gophercloud.MaybeString(opts.CompareType),
+		Value:        gophercloud.MaybeString(opts.Value),
+		Key:          gophercloud.MaybeString(opts.Key),
+		Invert:       opts.Invert,
+		AdminStateUp: opts.AdminStateUp,
+	}}
+
+	_, res.Err = perigee.Request("PUT", ruleResourceURL(c, policyID, ruleID), perigee.Options{
+		MoreHeaders:  c.Provider.AuthenticatedHeaders(),
+		CustomClient: &c.Provider.HTTPClient,
+		ReqBody:      &reqBody,
+		Results:      &res.Resp,
+		OkCodes:      []int{200},
+	})
+
+	return res
+}
+
+// DeleteRule will permanently delete a particular L7 rule from the given
+// policy.
+func DeleteRule(c *gophercloud.ServiceClient, policyID, ruleID string) DeleteResult {
+	var res DeleteResult
+
+	_, res.Err = perigee.Request("DELETE", ruleResourceURL(c, policyID, ruleID), perigee.Options{
+		MoreHeaders:  c.Provider.AuthenticatedHeaders(),
+		CustomClient: &c.Provider.HTTPClient,
+		OkCodes:      []int{204},
+	})
+
+	return res
+}
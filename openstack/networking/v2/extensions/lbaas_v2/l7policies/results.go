@@ -0,0 +1,186 @@
+package l7policies
+
+import (
+	"github.com/mitchellh/mapstructure"
+	"github.com/rackspace/gophercloud"
+	"github.com/rackspace/gophercloud/pagination"
+)
+
+// L7Policy is an internal representation of an L7 policy.
+type L7Policy struct {
+	ID             string `mapstructure:"id"`
+	Name           string `mapstructure:"name"`
+	ListenerID     string `mapstructure:"listener_id"`
+	Action         string `mapstructure:"action"`
+	RedirectPoolID string `mapstructure:"redirect_pool_id"`
+	RedirectURL    string `mapstructure:"redirect_url"`
+	Position       int    `mapstructure:"position"`
+	TenantID       string `mapstructure:"tenant_id"`
+	AdminStateUp   bool   `mapstructure:"admin_state_up"`
+	Rules          []gophercloud.Link `mapstructure:"rules"`
+}
+
+// L7Rule is an internal representation of an L7 rule belonging to a policy.
+type L7Rule struct {
+	ID           string `mapstructure:"id"`
+	Type         string `mapstructure:"type"`
+	CompareType  string `mapstructure:"compare_type"`
+	Value        string `mapstructure:"value"`
+	Key          string `mapstructure:"key"`
+	Invert       bool   `mapstructure:"invert"`
+	TenantID     string `mapstructure:"tenant_id"`
+	AdminStateUp bool   `mapstructure:"admin_state_up"`
+}
+
+// L7PolicyPage is the page returned by a pager when traversing over a
+// collection of L7 policies.
+type L7PolicyPage struct {
+	pagination.LinkedPageBase
+}
+
+// NextPageURL is invoked when a paginated collection of L7 policies has
+// reached the end of a page and the pager seeks to traverse over a new one.
+func (p L7PolicyPage) NextPageURL() (string, error) {
+	type resp struct {
+		Links []gophercloud.Link `mapstructure:"l7policies_links"`
+	}
+
+	var r resp
+	err := mapstructure.Decode(p.Body, &r)
+	if err != nil {
+		return "", err
+	}
+
+	return gophercloud.ExtractNextURL(r.Links)
+}
+
+// IsEmpty checks whether an L7PolicyPage struct is empty.
+func (p L7PolicyPage) IsEmpty() (bool, error) {
+	is, err := ExtractL7Policies(p)
+	return len(is) == 0, err
+}
+
+// ExtractL7Policies accepts a Page struct, specifically an L7PolicyPage
+// struct, and extracts the elements into a slice of L7Policy structs.
+func ExtractL7Policies(page pagination.Page) ([]L7Policy, error) {
+	var resp struct {
+		Policies []L7Policy `mapstructure:"l7policies"`
+	}
+
+	err := mapstructure.Decode(page.(L7PolicyPage).Body, &resp)
+
+	return resp.Policies, err
+}
+
+// L7RulePage is the page returned by a pager when traversing over a
+// collection of L7 rules belonging to a policy.
+type L7RulePage struct {
+	pagination.LinkedPageBase
+}
+
+// NextPageURL is invoked when a paginated collection of L7 rules has
+// reached the end of a page and the pager seeks to traverse over a new one.
+func (p L7RulePage) NextPageURL() (string, error) {
+	type resp struct {
+		Links []gophercloud.Link `mapstructure:"rules_links"`
+	}
+
+	var r resp
+	err := mapstructure.Decode(p.Body, &r)
+	if err != nil {
+		return "", err
+	}
+
+	return gophercloud.ExtractNextURL(r.Links)
+}
+
+// IsEmpty checks whether an L7RulePage struct is empty.
+func (p L7RulePage) IsEmpty() (bool, error) {
+	is, err := ExtractL7Rules(p)
+	return len(is) == 0, err
+}
+
+// ExtractL7Rules accepts a Page struct, specifically an L7RulePage struct,
+// and extracts the elements into a slice of L7Rule structs.
+func ExtractL7Rules(page pagination.Page) ([]L7Rule, error) {
+	var resp struct {
+		Rules []L7Rule `mapstructure:"rules"`
+	}
+
+	err := mapstructure.Decode(page.(L7RulePage).Body, &resp)
+
+	return resp.Rules, err
+}
+
+type commonResult struct {
+	gophercloud.Result
+}
+
+// Extract is a function that accepts a result and extracts an L7 policy.
+func (r commonResult) Extract() (*L7Policy, error) {
+	if r.Err != nil {
+		return nil, r.Err
+	}
+
+	var res struct {
+		Policy L7Policy `mapstructure:"l7policy"`
+	}
+
+	err := mapstructure.Decode(r.Resp, &res)
+
+	return &res.Policy, err
+}
+
+// CreateResult represents the result of a create operation.
+type CreateResult struct {
+	commonResult
+}
+
+// GetResult represents the result of a get operation.
+type GetResult struct {
+	commonResult
+}
+
+// UpdateResult represents the result of an update operation.
+type UpdateResult struct {
+	commonResult
+}
+
+// DeleteResult represents the result of a delete operation.
+type DeleteResult struct {
+	gophercloud.ErrResult
+}
+
+type commonRuleResult struct {
+	gophercloud.Result
+}
+
+// Extract is a function that accepts a result and extracts an L7 rule.
+func (r commonRuleResult) Extract() (*L7Rule, error) {
+	if r.Err != nil {
+		return nil, r.Err
+	}
+
+	var res struct {
+		Rule L7Rule `mapstructure:"rule"`
+	}
+
+	err := mapstructure.Decode(r.Resp, &res)
+
+	return &res.Rule, err
+}
+
+// CreateRuleResult represents the result of a CreateRule operation.
+type CreateRuleResult struct {
+	commonRuleResult
+}
+
+// GetRuleResult represents the result of a GetRule operation.
+type GetRuleResult struct {
+	commonRuleResult
+}
+
+// UpdateRuleResult represents the result of an UpdateRule operation.
+type UpdateRuleResult struct {
+	commonRuleResult
+}
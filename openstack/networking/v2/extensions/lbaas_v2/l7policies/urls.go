@@ -0,0 +1,21 @@
+package l7policies
+
+import "github.com/rackspace/gophercloud"
+
+const resourcePath = "lbaas/l7policies"
+
+func rootURL(c *gophercloud.ServiceClient) string {
+	return c.ServiceURL(resourcePath)
+}
+
+func resourceURL(c *gophercloud.ServiceClient, id string) string {
+	return c.ServiceURL(resourcePath, id)
+}
+
+func ruleRootURL(c *gophercloud.ServiceClient, policyID string) string {
+	return c.ServiceURL(resourcePath, policyID, "rules")
+}
+
+func ruleResourceURL(c *gophercloud.ServiceClient, policyID, ruleID string) string {
+	return c.ServiceURL(resourcePath, policyID, "rules", ruleID)
+}
@@ -0,0 +1,7 @@
+// Package listeners provides information and interaction with the
+// listeners API resource for the OpenStack Neutron LBaaS v2 extension.
+//
+// A listener represents a frontend port/protocol pair on a load balancer; it
+// is the v2 replacement for the protocol/port fields that lived directly on
+// a v1 vip.
+package listeners
@@ -0,0 +1,270 @@
+package listeners
+
+import (
+	"fmt"
+
+	"github.com/racker/perigee"
+	"github.com/rackspace/gophercloud"
+	"github.com/rackspace/gophercloud/openstack/utils"
+	"github.com/rackspace/gophercloud/pagination"
+)
+
+// ListOpts allows the filtering and sorting of paginated collections through
+// the API. Filtering is achieved by passing in struct field values that map to
+// the listener attributes you want to see returned.
+type ListOpts struct {
+	ID                   string
+	Name                 string
+	Description          string
+	LoadbalancerID       string
+	Protocol             string
+	ProtocolPort         int
+	TenantID             string
+	AdminStateUp         *bool
+	DefaultPoolID        string
+	Limit                int
+	Marker               string
+	SortKey              string
+	SortDir              string
+}
+
+// List returns a Pager which allows you to iterate over a collection of
+// listeners. It accepts a ListOpts struct, which allows you to filter and
+// sort the returned collection for greater efficiency.
+func List(c *gophercloud.ServiceClient, opts ListOpts) pagination.Pager {
+	q := make(map[string]string)
+	if opts.ID != "" {
+		q["id"] = opts.ID
+	}
+	if opts.Name != "" {
+		q["name"] = opts.Name
+	}
+	if opts.Description != "" {
+		q["description"] = opts.Description
+	}
+	if opts.LoadbalancerID != "" {
+		q["loadbalancer_id"] = opts.LoadbalancerID
+	}
+	if opts.Protocol != "" {
+		q["protocol"] = opts.Protocol
+	}
+	if opts.ProtocolPort != 0 {
+		q["protocol_port"] = fmt.Sprintf("%d", opts.ProtocolPort)
+	}
+	if opts.TenantID != "" {
+		q["tenant_id"] = opts.TenantID
+	}
+	if opts.AdminStateUp != nil {
+		q["admin_state_up"] = fmt.Sprintf("%t", *opts.AdminStateUp)
+	}
+	if opts.DefaultPoolID != "" {
+		q["default_pool_id"] = opts.DefaultPoolID
+	}
+	if opts.Marker != "" {
+		q["marker"] = opts.Marker
+	}
+	if opts.Limit != 0 {
+		q["limit"] = fmt.Sprintf("%d", opts.Limit)
+	}
+	if opts.SortKey != "" {
+		q["sort_key"] = opts.SortKey
+	}
+	if opts.SortDir != "" {
+		q["sort_dir"] = opts.SortDir
+	}
+
+	u := rootURL(c) + utils.BuildQuery(q)
+
+	return pagination.NewPager(c, u, func(r pagination.LastHTTPResponse) pagination.Page {
+		return ListenerPage{pagination.LinkedPageBase{LastHTTPResponse: r}}
+	})
+}
+
+// CreateOpts contains all the values needed to create a new listener.
+type CreateOpts struct {
+	// Required. The protocol - can either be TCP, HTTP, HTTPS or TERMINATED_HTTPS.
+	Protocol string
+
+	// Required. The port on which to listen for client traffic.
+	ProtocolPort int
+
+	// Required. The load balancer on which to provision this listener.
+	LoadbalancerID string
+
+	// Optional. Human-readable name for the listener. Does not have to be
+	// unique.
+	Name string
+
+	// Optional. Human-readable description for the listener.
+	Description string
+
+	// Optional. The ID of the default pool with which the listener is
+	// associated.
+	DefaultPoolID string
+
+	// Required for admins. Indicates the owner of the listener.
+	TenantID string
+
+	// Optional. A reference to a container holding a TLS certificate, used
+	// when Protocol is TERMINATED_HTTPS.
+	DefaultTlsContainerRef string
+
+	// Optional. A list of references to containers holding TLS certificates
+	// for additional SNI hosts, used when Protocol is TERMINATED_HTTPS.
+	SniContainerRefs []string
+
+	// Optional. The maximum number of connections allowed for the listener.
+	ConnLimit *int
+
+	// Optional. The administrative state of the listener. A valid value is
+	// true (UP) or false (DOWN).
+	AdminStateUp *bool
+}
+
+var (
+	errProtocolRequired       = fmt.Errorf("Protocol is required")
+	errProtocolPortRequired   = fmt.Errorf("Protocol port is required")
+	errLoadbalancerIDRequired = fmt.Errorf("LoadbalancerID is required")
+)
+
+// Create is an operation which provisions a new listener based on the
+// configuration defined in the CreateOpts struct. Once the request is
+// validated and progress has started on the provisioning process, a
+// CreateResult will be returned.
+func Create(c *gophercloud.ServiceClient, opts CreateOpts) CreateResult {
+	var res CreateResult
+
+	if opts.Protocol == "" {
+		res.Err = errProtocolRequired
+		return res
+	}
+	if opts.ProtocolPort == 0 {
+		res.Err = errProtocolPortRequired
+		return res
+	}
+	if opts.LoadbalancerID == "" {
+		res.Err = errLoadbalancerIDRequired
+		return res
+	}
+
+	type listener struct {
+		Protocol               string   `json:"protocol"`
+		ProtocolPort           int      `json:"protocol_port"`
+		LoadbalancerID         string   `json:"loadbalancer_id"`
+		Name                   *string  `json:"name,omitempty"`
+		Description            *string  `json:"description,omitempty"`
+		DefaultPoolID          *string  `json:"default_pool_id,omitempty"`
+		TenantID               *string  `json:"tenant_id,omitempty"`
+		DefaultTlsContainerRef *string  `json:"default_tls_container_ref,omitempty"`
+		SniContainerRefs       []string `json:"sni_container_refs,omitempty"`
+		ConnLimit              *int     `json:"connection_limit,omitempty"`
+		AdminStateUp           *bool    `json:"admin_state_up,omitempty"`
+	}
+
+	type request struct {
+		Listener listener `json:"listener"`
+	}
+
+	reqBody := request{Listener: listener{
+		Protocol:               opts.Protocol,
+		ProtocolPort:           opts.ProtocolPort,
+		LoadbalancerID:         opts.LoadbalancerID,
+		Name:                   gophercloud.MaybeString(opts.Name),
+		Description:            gophercloud.MaybeString(opts.Description),
+		DefaultPoolID:          gophercloud.MaybeString(opts.DefaultPoolID),
+		TenantID:               gophercloud.MaybeString(opts.TenantID),
+		DefaultTlsContainerRef: gophercloud.MaybeString(opts.DefaultTlsContainerRef),
+		SniContainerRefs:       opts.SniContainerRefs,
+		ConnLimit:              opts.ConnLimit,
+		AdminStateUp:           opts.AdminStateUp,
+	}}
+
+	_, res.Err = perigee.Request("POST", rootURL(c), perigee.Options{
+		MoreHeaders:  c.Provider.AuthenticatedHeaders(),
+		CustomClient: &c.Provider.HTTPClient,
+		ReqBody:      &reqBody,
+		Results:      &res.Resp,
+		OkCodes:      []int{201},
+	})
+
+	return res
+}
+
+// Get retrieves a particular listener based on its unique ID.
+func Get(c *gophercloud.ServiceClient, id string) GetResult {
+	var res GetResult
+
+	_, res.Err = perigee.Request("GET", resourceURL(c, id), perigee.Options{
+		MoreHeaders:  c.Provider.AuthenticatedHeaders(),
+		CustomClient: &c.Provider.HTTPClient,
+		Results:      &res.Resp,
+		OkCodes:      []int{200},
+	})
+
+	return res
+}
+
+// UpdateOpts contains the values used when updating a listener.
+type UpdateOpts struct {
+	Name                   string
+	Description            string
+	DefaultPoolID          string
+	DefaultTlsContainerRef string
+	SniContainerRefs       []string
+	ConnLimit              *int
+	AdminStateUp           *bool
+}
+
+// Update is an operation which modifies the attributes of the specified
+// listener.
+func Update(c *gophercloud.ServiceClient, id string, opts UpdateOpts) UpdateResult {
+	var res UpdateResult
+
+	type listener struct {
+		Name                   *string  `json:"name,omitempty"`
+		Description            *string  `json:"description,omitempty"`
+		DefaultPoolID          *string  `json:"default_pool_id,omitempty"`
+		DefaultTlsContainerRef *string  `json:"default_tls_container_ref,omitempty"`
+		SniContainerRefs       []string `json:"sni_container_refs,omitempty"`
+		ConnLimit              *int     `json:"connection_limit,omitempty"`
+		AdminStateUp           *bool    `json:"admin_state_up,omitempty"`
+	}
+
+	type request struct {
+		Listener listener `json:"listener"`
+	}
+
+	reqBody := request{Listener: listener{
+		Name:                   gophercloud.MaybeString(opts.Name),
+		Description:            gophercloud.MaybeString(opts.Description),
+		DefaultPoolID:          gophercloud.MaybeString(opts.DefaultPoolID),
+		DefaultTlsContainerRef: gophercloud.MaybeString(opts.DefaultTlsContainerRef),
+		SniContainerRefs:       opts.SniContainerRefs,
+		ConnLimit:              opts.ConnLimit,
+		AdminStateUp:           opts.AdminStateUp,
+	}}
+
+	_, res.Err = perigee.Request("PUT", resourceURL(c, id), perigee.Options{
+		MoreHeaders:  c.Provider.AuthenticatedHeaders(),
+		CustomClient: &c.Provider.HTTPClient,
+		ReqBody:      &reqBody,
+		Results:      &res.Resp,
+		OkCodes:      []int{200},
+	})
+
+	return res
+}
+
+// Delete will permanently delete a particular listener based on its unique
+// ID.
+func Delete(c *gophercloud.ServiceClient, id string) DeleteResult {
+	var res DeleteResult
+
+	_, res.Err = perigee.Request("DELETE", resourceURL(c, id), perigee.Options{
+		MoreHeaders:  c.Provider.AuthenticatedHeaders(),
+		CustomClient: &c.Provider.HTTPClient,
+		OkCodes:      []int{204},
+	})
+
+	return res
+}
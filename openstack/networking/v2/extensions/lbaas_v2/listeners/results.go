@@ -0,0 +1,102 @@
+package listeners
+
+import (
+	"github.com/mitchellh/mapstructure"
+	"github.com/rackspace/gophercloud"
+	"github.com/rackspace/gophercloud/pagination"
+)
+
+// Listener is an internal representation of a listener.
+type Listener struct {
+	ID                     string             `mapstructure:"id"`
+	Name                   string             `mapstructure:"name"`
+	Description            string             `mapstructure:"description"`
+	Protocol               string             `mapstructure:"protocol"`
+	ProtocolPort           int                `mapstructure:"protocol_port"`
+	TenantID               string             `mapstructure:"tenant_id"`
+	AdminStateUp           bool               `mapstructure:"admin_state_up"`
+	DefaultPoolID          string             `mapstructure:"default_pool_id"`
+	DefaultTlsContainerRef string             `mapstructure:"default_tls_container_ref"`
+	SniContainerRefs       []string           `mapstructure:"sni_container_refs"`
+	ConnLimit              int                `mapstructure:"connection_limit"`
+	Loadbalancers          []gophercloud.Link `mapstructure:"loadbalancers"`
+}
+
+// ListenerPage is the page returned by a pager when traversing over a
+// collection of listeners.
+type ListenerPage struct {
+	pagination.LinkedPageBase
+}
+
+// NextPageURL is invoked when a paginated collection of listeners has
+// reached the end of a page and the pager seeks to traverse over a new one.
+func (p ListenerPage) NextPageURL() (string, error) {
+	type resp struct {
+		Links []gophercloud.Link `mapstructure:"listeners_links"`
+	}
+
+	var r resp
+	err := mapstructure.Decode(p.Body, &r)
+	if err != nil {
+		return "", err
+	}
+
+	return gophercloud.ExtractNextURL(r.Links)
+}
+
+// IsEmpty checks whether a ListenerPage struct is empty.
+func (p ListenerPage) IsEmpty() (bool, error) {
+	is, err := ExtractListeners(p)
+	return len(is) == 0, err
+}
+
+// ExtractListeners accepts a Page struct, specifically a ListenerPage
+// struct, and extracts the elements into a slice of Listener structs.
+func ExtractListeners(page pagination.Page) ([]Listener, error) {
+	var resp struct {
+		Listeners []Listener `mapstructure:"listeners"`
+	}
+
+	err := mapstructure.Decode(page.(ListenerPage).Body, &resp)
+
+	return resp.Listeners, err
+}
+
+type commonResult struct {
+	gophercloud.Result
+}
+
+// Extract is a function that accepts a result and extracts a listener.
+func (r commonResult) Extract() (*Listener, error) {
+	if r.Err != nil {
+		return nil, r.Err
+	}
+
+	var res struct {
+		Listener Listener `mapstructure:"listener"`
+	}
+
+	err := mapstructure.Decode(r.Resp, &res)
+
+	return &res.Listener, err
+}
+
+// CreateResult represents the result of a create operation.
+type CreateResult struct {
+	commonResult
+}
+
+// GetResult represents the result of a get operation.
+type GetResult struct {
+	commonResult
+}
+
+// UpdateResult represents the result of an update operation.
+type UpdateResult struct {
+	commonResult
+}
+
+// DeleteResult represents the result of a delete operation.
+type DeleteResult struct {
+	gophercloud.ErrResult
+}
@@ -0,0 +1,13 @@
+package listeners
+
+import "github.com/rackspace/gophercloud"
+
+const resourcePath = "lbaas/listeners"
+
+func rootURL(c *gophercloud.ServiceClient) string {
+	return c.ServiceURL(resourcePath)
+}
+
+func resourceURL(c *gophercloud.ServiceClient, id string) string {
+	return c.ServiceURL(resourcePath, id)
+}
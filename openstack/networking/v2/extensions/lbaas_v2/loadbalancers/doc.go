@@ -0,0 +1,7 @@
+// Package loadbalancers provides information and interaction with the
+// loadbalancers API resource for the OpenStack Neutron LBaaS v2 extension.
+//
+// Unlike the legacy lbaas (v1) vip model, a v2 load balancer is a standalone
+// object: listeners, pools, members, and monitors are created and managed
+// independently and then associated back to a load balancer by ID.
+package loadbalancers
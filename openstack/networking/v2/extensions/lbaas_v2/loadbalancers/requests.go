@@ -0,0 +1,246 @@
+package loadbalancers
+
+import (
+	"fmt"
+
+	"github.com/racker/perigee"
+	"github.com/rackspace/gophercloud"
+	"github.com/rackspace/gophercloud/openstack/utils"
+	"github.com/rackspace/gophercloud/pagination"
+)
+
+// ListOpts allows the filtering and sorting of paginated collections through
+// the API. Filtering is achieved by passing in struct field values that map to
+// the load balancer attributes you want to see returned.
+type ListOpts struct {
+	ID           string
+	Name         string
+	Description  string
+	VipAddress   string
+	VipSubnetID  string
+	VipPortID    string
+	Provider     string
+	TenantID     string
+	AdminStateUp *bool
+	Status       string
+	Limit        int
+	Marker       string
+	SortKey      string
+	SortDir      string
+}
+
+// List returns a Pager which allows you to iterate over a collection of
+// load balancers. It accepts a ListOpts struct, which allows you to filter
+// and sort the returned collection for greater efficiency.
+func List(c *gophercloud.ServiceClient, opts ListOpts) pagination.Pager {
+	q := make(map[string]string)
+	if opts.ID != "" {
+		q["id"] = opts.ID
+	}
+	if opts.Name != "" {
+		q["name"] = opts.Name
+	}
+	if opts.Description != "" {
+		q["description"] = opts.Description
+	}
+	if opts.VipAddress != "" {
+		q["vip_address"] = opts.VipAddress
+	}
+	if opts.VipSubnetID != "" {
+		q["vip_subnet_id"] = opts.VipSubnetID
+	}
+	if opts.VipPortID != "" {
+		q["vip_port_id"] = opts.VipPortID
+	}
+	if opts.Provider != "" {
+		q["provider"] = opts.Provider
+	}
+	if opts.TenantID != "" {
+		q["tenant_id"] = opts.TenantID
+	}
+	if opts.AdminStateUp != nil {
+		q["admin_state_up"] = fmt.Sprintf("%t", *opts.AdminStateUp)
+	}
+	if opts.Status != "" {
+		q["status"] = opts.Status
+	}
+	if opts.Marker != "" {
+		q["marker"] = opts.Marker
+	}
+	if opts.Limit != 0 {
+		q["limit"] = fmt.Sprintf("%d", opts.Limit)
+	}
+	if opts.SortKey != "" {
+		q["sort_key"] = opts.SortKey
+	}
+	if opts.SortDir != "" {
+		q["sort_dir"] = opts.SortDir
+	}
+
+	u := rootURL(c) + utils.BuildQuery(q)
+
+	return pagination.NewPager(c, u, func(r pagination.LastHTTPResponse) pagination.Page {
+		return LoadBalancerPage{pagination.LinkedPageBase{LastHTTPResponse: r}}
+	})
+}
+
+// CreateOpts contains all the values needed to create a new load balancer.
+type CreateOpts struct {
+	// Optional. Human-readable name for the load balancer. Does not have to be
+	// unique.
+	Name string
+
+	// Optional. Human-readable description for the load balancer.
+	Description string
+
+	// Required. The network on which to allocate the load balancer's address.
+	VipSubnetID string
+
+	// Optional. The IP address of the load balancer.
+	VipAddress string
+
+	// Optional. The name of the provider driver to use for this load balancer.
+	Provider string
+
+	// Required for admins. Indicates the owner of the load balancer.
+	TenantID string
+
+	// Optional. The administrative state of the load balancer. A valid value
+	// is true (UP) or false (DOWN).
+	AdminStateUp *bool
+}
+
+var errVipSubnetIDRequired = fmt.Errorf("VipSubnetID is required")
+
+// Create is an operation which provisions a new loadbalancer based on the
+// configuration defined in the CreateOpts struct. Once the request is
+// validated and progress has started on the provisioning process, a
+// CreateResult will be returned.
+//
+// Users with an admin role can create load balancers on behalf of other
+// tenants by specifying a TenantID attribute different than their own.
+func Create(c *gophercloud.ServiceClient, opts CreateOpts) CreateResult {
+	var res CreateResult
+
+	if opts.VipSubnetID == "" {
+		res.Err = errVipSubnetIDRequired
+		return res
+	}
+
+	type loadbalancer struct {
+		Name         string  `json:"name,omitempty"`
+		Description  *string `json:"description,omitempty"`
+		VipSubnetID  string  `json:"vip_subnet_id"`
+		VipAddress   *string `json:"vip_address,omitempty"`
+		Provider     *string `json:"provider,omitempty"`
+		TenantID     *string `json:"tenant_id,omitempty"`
+		AdminStateUp *bool   `json:"admin_state_up,omitempty"`
+	}
+
+	type request struct {
+		LoadBalancer loadbalancer `json:"loadbalancer"`
+	}
+
+	reqBody := request{LoadBalancer: loadbalancer{
+		Name:         opts.Name,
+		Description:  gophercloud.MaybeString(opts.Description),
+		VipSubnetID:  opts.VipSubnetID,
+		VipAddress:   gophercloud.MaybeString(opts.VipAddress),
+		Provider:     gophercloud.MaybeString(opts.Provider),
+		TenantID:     gophercloud.MaybeString(opts.TenantID),
+		AdminStateUp: opts.AdminStateUp,
+	}}
+
+	_, res.Err = perigee.Request("POST", rootURL(c), perigee.Options{
+		MoreHeaders:  c.Provider.AuthenticatedHeaders(),
+		CustomClient: &c.Provider.HTTPClient,
+		ReqBody:      &reqBody,
+		Results:      &res.Resp,
+		OkCodes:      []int{201},
+	})
+
+	return res
+}
+
+// Get retrieves a particular load balancer based on its unique ID.
+func Get(c *gophercloud.ServiceClient, id string) GetResult {
+	var res GetResult
+
+	_, res.Err = perigee.Request("GET", resourceURL(c, id), perigee.Options{
+		MoreHeaders:  c.Provider.AuthenticatedHeaders(),
+		CustomClient: &c.Provider.HTTPClient,
+		Results:      &res.Resp,
+		OkCodes:      []int{200},
+	})
+
+	return res
+}
+
+// UpdateOpts contains the values used when updating a load balancer.
+type UpdateOpts struct {
+	Name         string
+	Description  string
+	AdminStateUp *bool
+}
+
+// Update is an operation which modifies the attributes of the specified
+// load balancer.
+func Update(c *gophercloud.ServiceClient, id string, opts UpdateOpts) UpdateResult {
+	var res UpdateResult
+
+	type loadbalancer struct {
+		Name         *string `json:"name,omitempty"`
+		Description  *string `json:"description,omitempty"`
+		AdminStateUp *bool   `json:"admin_state_up,omitempty"`
+	}
+
+	type request struct {
+		LoadBalancer loadbalancer `json:"loadbalancer"`
+	}
+
+	reqBody := request{LoadBalancer: loadbalancer{
+		Name:         gophercloud.MaybeString(opts.Name),
+		Description:  gophercloud.MaybeString(opts.Description),
+		AdminStateUp: opts.AdminStateUp,
+	}}
+
+	_, res.Err = perigee.Request("PUT", resourceURL(c, id), perigee.Options{
+		MoreHeaders:  c.Provider.AuthenticatedHeaders(),
+		CustomClient: &c.Provider.HTTPClient,
+		ReqBody:      &reqBody,
+		Results:      &res.Resp,
+		OkCodes:      []int{200},
+	})
+
+	return res
+}
+
+// Delete will permanently delete a particular load balancer based on its
+// unique ID.
+func Delete(c *gophercloud.ServiceClient, id string) DeleteResult {
+	var res DeleteResult
+
+	_, res.Err = perigee.Request("DELETE", resourceURL(c, id), perigee.Options{
+		MoreHeaders:  c.Provider.AuthenticatedHeaders(),
+		CustomClient: &c.Provider.HTTPClient,
+		OkCodes:      []int{204},
+	})
+
+	return res
+}
+
+// GetStatuses will return the status tree of the specified load balancer,
+// including the nested listeners, pools, members, and monitors it manages,
+// in a single request.
+func GetStatuses(c *gophercloud.ServiceClient, id string) StatusesResult {
+	var res StatusesResult
+
+	_, res.Err = perigee.Request("GET", statusesURL(c, id), perigee.Options{
+		MoreHeaders:  c.Provider.AuthenticatedHeaders(),
+		CustomClient: &c.Provider.HTTPClient,
+		Results:      &res.Resp,
+		OkCodes:      []int{200},
+	})
+
+	return res
+}
@@ -0,0 +1,178 @@
+package loadbalancers
+
+import (
+	"github.com/mitchellh/mapstructure"
+	"github.com/rackspace/gophercloud"
+	"github.com/rackspace/gophercloud/pagination"
+)
+
+// LoadBalancer is an internal representation of a load balancer. You can
+// use this struct to find out whether the load balancer is provisioned,
+// what its ID is, and its attributes.
+type LoadBalancer struct {
+	ID           string `mapstructure:"id"`
+	Name         string `mapstructure:"name"`
+	Description  string `mapstructure:"description"`
+	VipSubnetID  string `mapstructure:"vip_subnet_id"`
+	VipAddress   string `mapstructure:"vip_address"`
+	VipPortID    string `mapstructure:"vip_port_id"`
+	Provider     string `mapstructure:"provider"`
+	TenantID     string `mapstructure:"tenant_id"`
+	AdminStateUp bool   `mapstructure:"admin_state_up"`
+	Status       string `mapstructure:"provisioning_status"`
+	Listeners    []gophercloud.Link `mapstructure:"listeners"`
+}
+
+// LoadBalancerPage is the page returned by a pager when traversing over a
+// collection of load balancers.
+type LoadBalancerPage struct {
+	pagination.LinkedPageBase
+}
+
+// NextPageURL is invoked when a paginated collection of load balancers has
+// reached the end of a page and the pager seeks to traverse over a new one.
+// In order to do this, it needs to construct the next page's URL.
+func (p LoadBalancerPage) NextPageURL() (string, error) {
+	type resp struct {
+		Links []gophercloud.Link `mapstructure:"loadbalancers_links"`
+	}
+
+	var r resp
+	err := mapstructure.Decode(p.Body, &r)
+	if err != nil {
+		return "", err
+	}
+
+	return gophercloud.ExtractNextURL(r.Links)
+}
+
+// IsEmpty checks whether a LoadBalancerPage struct is empty.
+func (p LoadBalancerPage) IsEmpty() (bool, error) {
+	is, err := ExtractLoadBalancers(p)
+	return len(is) == 0, err
+}
+
+// ExtractLoadBalancers accepts a Page struct, specifically a LoadBalancerPage
+// struct, and extracts the elements into a slice of LoadBalancer structs. In
+// other words, a generic collection is mapped into a relevant slice.
+func ExtractLoadBalancers(page pagination.Page) ([]LoadBalancer, error) {
+	var resp struct {
+		LoadBalancers []LoadBalancer `mapstructure:"loadbalancers"`
+	}
+
+	err := mapstructure.Decode(page.(LoadBalancerPage).Body, &resp)
+
+	return resp.LoadBalancers, err
+}
+
+type commonResult struct {
+	gophercloud.Result
+}
+
+// Extract is a function that accepts a result and extracts a load balancer.
+func (r commonResult) Extract() (*LoadBalancer, error) {
+	if r.Err != nil {
+		return nil, r.Err
+	}
+
+	var res struct {
+		LoadBalancer LoadBalancer `mapstructure:"loadbalancer"`
+	}
+
+	err := mapstructure.Decode(r.Resp, &res)
+
+	return &res.LoadBalancer, err
+}
+
+// CreateResult represents the result of a create operation.
+type CreateResult struct {
+	commonResult
+}
+
+// GetResult represents the result of a get operation.
+type GetResult struct {
+	commonResult
+}
+
+// UpdateResult represents the result of an update operation.
+type UpdateResult struct {
+	commonResult
+}
+
+// DeleteResult represents the result of a delete operation.
+type DeleteResult struct {
+	gophercloud.ErrResult
+}
+
+// ListenerStatus represents the status of a listener nested within a load
+// balancer's status tree.
+type ListenerStatus struct {
+	ID                 string           `mapstructure:"id"`
+	Name               string           `mapstructure:"name"`
+	OperatingStatus    string           `mapstructure:"operating_status"`
+	ProvisioningStatus string           `mapstructure:"provisioning_status"`
+	Pools              []PoolStatus     `mapstructure:"pools"`
+}
+
+// PoolStatus represents the status of a pool nested within a load balancer's
+// status tree.
+type PoolStatus struct {
+	ID                 string            `mapstructure:"id"`
+	Name               string            `mapstructure:"name"`
+	OperatingStatus    string            `mapstructure:"operating_status"`
+	ProvisioningStatus string            `mapstructure:"provisioning_status"`
+	Members            []MemberStatus    `mapstructure:"members"`
+	Monitor            *MonitorStatus    `mapstructure:"healthmonitor"`
+}
+
+// MemberStatus represents the status of a member nested within a pool's
+// status tree.
+type MemberStatus struct {
+	ID                 string `mapstructure:"id"`
+	Address            string `mapstructure:"address"`
+	ProtocolPort       int    `mapstructure:"protocol_port"`
+	OperatingStatus    string `mapstructure:"operating_status"`
+	ProvisioningStatus string `mapstructure:"provisioning_status"`
+}
+
+// MonitorStatus represents the status of a health monitor nested within a
+// pool's status tree.
+type MonitorStatus struct {
+	ID                 string `mapstructure:"id"`
+	Type               string `mapstructure:"type"`
+	ProvisioningStatus string `mapstructure:"provisioning_status"`
+}
+
+// LoadBalancerStatusTree is the root of the nested status tree returned by
+// GetStatuses: the load balancer itself along with every listener, pool,
+// member, and monitor it currently manages.
+type LoadBalancerStatusTree struct {
+	ID                 string           `mapstructure:"id"`
+	Name               string           `mapstructure:"name"`
+	OperatingStatus    string           `mapstructure:"operating_status"`
+	ProvisioningStatus string           `mapstructure:"provisioning_status"`
+	Listeners          []ListenerStatus `mapstructure:"listeners"`
+}
+
+// StatusesResult represents the result of a GetStatuses operation.
+type StatusesResult struct {
+	gophercloud.Result
+}
+
+// Extract is a function that accepts a result and extracts the nested
+// status tree for a load balancer.
+func (r StatusesResult) Extract() (*LoadBalancerStatusTree, error) {
+	if r.Err != nil {
+		return nil, r.Err
+	}
+
+	var res struct {
+		Statuses struct {
+			LoadBalancer LoadBalancerStatusTree `mapstructure:"loadbalancer"`
+		} `mapstructure:"statuses"`
+	}
+
+	err := mapstructure.Decode(r.Resp, &res)
+
+	return &res.Statuses.LoadBalancer, err
+}
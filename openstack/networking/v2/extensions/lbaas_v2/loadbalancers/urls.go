@@ -0,0 +1,17 @@
+package loadbalancers
+
+import "github.com/rackspace/gophercloud"
+
+const resourcePath = "lbaas/loadbalancers"
+
+func rootURL(c *gophercloud.ServiceClient) string {
+	return c.ServiceURL(resourcePath)
+}
+
+func resourceURL(c *gophercloud.ServiceClient, id string) string {
+	return c.ServiceURL(resourcePath, id)
+}
+
+func statusesURL(c *gophercloud.ServiceClient, id string) string {
+	return c.ServiceURL(resourcePath, id, "statuses")
+}
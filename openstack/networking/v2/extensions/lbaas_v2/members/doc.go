@@ -0,0 +1,6 @@
+// Package members provides information and interaction with the members
+// API resource for the OpenStack Neutron LBaaS v2 extension.
+//
+// A member is a back-end server address/port pair that belongs to a pool
+// and receives traffic distributed by the pool's load-balancing algorithm.
+package members
@@ -0,0 +1,226 @@
+package members
+
+import (
+	"fmt"
+
+	"github.com/racker/perigee"
+	"github.com/rackspace/gophercloud"
+	"github.com/rackspace/gophercloud/openstack/utils"
+	"github.com/rackspace/gophercloud/pagination"
+)
+
+// ListOpts allows the filtering and sorting of paginated collections through
+// the API. Filtering is achieved by passing in struct field values that map to
+// the member attributes you want to see returned.
+type ListOpts struct {
+	ID           string
+	Name         string
+	SubnetID     string
+	Address      string
+	ProtocolPort int
+	TenantID     string
+	AdminStateUp *bool
+	Weight       int
+	Limit        int
+	Marker       string
+	SortKey      string
+	SortDir      string
+}
+
+// List returns a Pager which allows you to iterate over a collection of
+// members belonging to the given pool. It accepts a ListOpts struct, which
+// allows you to filter and sort the returned collection for greater
+// efficiency.
+func List(c *gophercloud.ServiceClient, poolID string, opts ListOpts) pagination.Pager {
+	q := make(map[string]string)
+	if opts.ID != "" {
+		q["id"] = opts.ID
+	}
+	if opts.Name != "" {
+		q["name"] = opts.Name
+	}
+	if opts.SubnetID != "" {
+		q["subnet_id"] = opts.SubnetID
+	}
+	if opts.Address != "" {
+		q["address"] = opts.Address
+	}
+	if opts.ProtocolPort != 0 {
+		q["protocol_port"] = fmt.Sprintf("%d", opts.ProtocolPort)
+	}
+	if opts.TenantID != "" {
+		q["tenant_id"] = opts.TenantID
+	}
+	if opts.AdminStateUp != nil {
+		q["admin_state_up"] = fmt.Sprintf("%t", *opts.AdminStateUp)
+	}
+	if opts.Weight != 0 {
+		q["weight"] = fmt.Sprintf("%d", opts.Weight)
+	}
+	if opts.Marker != "" {
+		q["marker"] = opts.Marker
+	}
+	if opts.Limit != 0 {
+		q["limit"] = fmt.Sprintf("%d", opts.Limit)
+	}
+	if opts.SortKey != "" {
+		q["sort_key"] = opts.SortKey
+	}
+	if opts.SortDir != "" {
+		q["sort_dir"] = opts.SortDir
+	}
+
+	u := rootURL(c, poolID) + utils.BuildQuery(q)
+
+	return pagination.NewPager(c, u, func(r pagination.LastHTTPResponse) pagination.Page {
+		return MemberPage{pagination.LinkedPageBase{LastHTTPResponse: r}}
+	})
+}
+
+// CreateOpts contains all the values needed to create a new member.
+type CreateOpts struct {
+	// Required. The IP address of the member to receive traffic from the
+	// load balancer.
+	Address string
+
+	// Required. The port on which to listen for client traffic.
+	ProtocolPort int
+
+	// Required. The subnet on which the member's address is reachable.
+	SubnetID string
+
+	// Required for admins. Indicates the owner of the member.
+	TenantID string
+
+	// Optional. A positive integer value indicating the relative portion of
+	// traffic that this member should receive from the pool. A higher value
+	// indicates a higher proportion of traffic.
+	Weight *int
+
+	// Optional. The administrative state of the member. A valid value is
+	// true (UP) or false (DOWN).
+	AdminStateUp *bool
+}
+
+var (
+	errAddressRequired      = fmt.Errorf("Address is required")
+	errMemberPortRequired   = fmt.Errorf("ProtocolPort is required")
+	errMemberSubnetRequired = fmt.Errorf("SubnetID is required")
+)
+
+// Create is an operation which provisions a new member based on the
+// configuration defined in the CreateOpts struct. Once the request is
+// validated and progress has started on the provisioning process, a
+// CreateResult will be returned.
+func Create(c *gophercloud.ServiceClient, poolID string, opts CreateOpts) CreateResult {
+	var res CreateResult
+
+	if opts.Address == "" {
+		res.Err = errAddressRequired
+		return res
+	}
+	if opts.ProtocolPort == 0 {
+		res.Err = errMemberPortRequired
+		return res
+	}
+	if opts.SubnetID == "" {
+		res.Err = errMemberSubnetRequired
+		return res
+	}
+
+	type member struct {
+		Address      string  `json:"address"`
+		ProtocolPort int     `json:"protocol_port"`
+		SubnetID     string  `json:"subnet_id"`
+		TenantID     *string `json:"tenant_id,omitempty"`
+		Weight       *int    `json:"weight,omitempty"`
+		AdminStateUp *bool   `json:"admin_state_up,omitempty"`
+	}
+
+	type request struct {
+		Member member `json:"member"`
+	}
+
+	reqBody := request{Member: member{
+		Address:      opts.Address,
+		ProtocolPort: opts.ProtocolPort,
+		SubnetID:     opts.SubnetID,
+		TenantID:     gophercloud.MaybeString(opts.TenantID),
+		Weight:       opts.Weight,
+		AdminStateUp: opts.AdminStateUp,
+	}}
+
+	_, res.Err = perigee.Request("POST", rootURL(c, poolID), perigee.Options{
+		MoreHeaders:  c.Provider.AuthenticatedHeaders(),
+		CustomClient: &c.Provider.HTTPClient,
+		ReqBody:      &reqBody,
+		Results:      &res.Resp,
+		OkCodes:      []int{201},
+	})
+
+	return res
+}
+
+// Get retrieves a particular member of the given pool based on its unique
+// ID.
+func Get(c *gophercloud.ServiceClient, poolID, memberID string) GetResult {
+	var res GetResult
+
+	_, res.Err = perigee.Request("GET", resourceURL(c, poolID, memberID), perigee.Options{
+		MoreHeaders:  c.Provider.AuthenticatedHeaders(),
+		CustomClient: &c.Provider.HTTPClient,
+		Results:      &res.Resp,
+		OkCodes:      []int{200},
+	})
+
+	return res
+}
+
+// UpdateOpts contains the values used when updating a member.
+type UpdateOpts struct {
+	Weight       *int
+	AdminStateUp *bool
+}
+
+// Update is an operation which modifies the attributes of the specified
+// member.
+func Update(c *gophercloud.ServiceClient, poolID, memberID string, opts UpdateOpts) UpdateResult {
+	var res UpdateResult
+
+	type member struct {
+		Weight       *int  `json:"weight,omitempty"`
+		AdminStateUp *bool `json:"admin_state_up,omitempty"`
+	}
+
+	type request struct {
+		Member member `json:"member"`
+	}
+
+	reqBody := request{Member: member{
+		Weight:       opts.Weight,
+		AdminStateUp: opts.AdminStateUp,
+	}}
+
+	_, res.Err = perigee.Request("PUT", resourceURL(c, poolID, memberID), perigee.Options{
+		MoreHeaders:  c.Provider.AuthenticatedHeaders(),
+		CustomClient: &c.Provider.HTTPClient,
+		ReqBody:      &reqBody,
+		Results:      &res.Resp,
+		OkCodes:      []int{200},
+	})
+
+	return res
+}
+
+// Delete will permanently delete a particular member from the given pool.
+func Delete(c *gophercloud.ServiceClient, poolID, memberID string) DeleteResult {
+	var res DeleteResult
+
+	_, res.Err = perigee.Request("DELETE", resourceURL(c, poolID, memberID), perigee.Options{
+		MoreHeaders:  c.Provider.AuthenticatedHeaders(),
+		CustomClient: &c.Provider.HTTPClient,
+		OkCodes:      []int{204},
+	})
+
+	return res
+}
@@ -0,0 +1,11 @@
+package members
+
+import "github.com/rackspace/gophercloud"
+
+func rootURL(c *gophercloud.ServiceClient, poolID string) string {
+	return c.ServiceURL("lbaas", "pools", poolID, "members")
+}
+
+func resourceURL(c *gophercloud.ServiceClient, poolID, memberID string) string {
+	return c.ServiceURL("lbaas", "pools", poolID, "members", memberID)
+}
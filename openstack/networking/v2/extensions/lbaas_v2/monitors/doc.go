@@ -0,0 +1,6 @@
+// Package monitors provides information and interaction with the
+// monitors API resource for the OpenStack Neutron LBaaS v2 extension.
+//
+// A monitor is associated with a pool and periodically checks the health of
+// its members, taking unhealthy members out of rotation.
+package monitors
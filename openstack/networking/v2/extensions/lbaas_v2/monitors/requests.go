@@ -0,0 +1,289 @@
+package monitors
+
+import (
+	"fmt"
+
+	"github.com/racker/perigee"
+	"github.com/rackspace/gophercloud"
+	"github.com/rackspace/gophercloud/openstack/utils"
+	"github.com/rackspace/gophercloud/pagination"
+)
+
+// ListOpts allows the filtering and sorting of paginated collections through
+// the API. Filtering is achieved by passing in struct field values that map to
+// the monitor attributes you want to see returned.
+type ListOpts struct {
+	ID            string
+	Name          string
+	PoolID        string
+	Type          string
+	Delay         int
+	Timeout       int
+	MaxRetries    int
+	HTTPMethod    string
+	URLPath       string
+	ExpectedCodes string
+	TenantID      string
+	AdminStateUp  *bool
+	Limit         int
+	Marker        string
+	SortKey       string
+	SortDir       string
+}
+
+// List returns a Pager which allows you to iterate over a collection of
+// health monitors. It accepts a ListOpts struct, which allows you to filter
+// and sort the returned collection for greater efficiency.
+func List(c *gophercloud.ServiceClient, opts ListOpts) pagination.Pager {
+	q := make(map[string]string)
+	if opts.ID != "" {
+		q["id"] = opts.ID
+	}
+	if opts.Name != "" {
+		q["name"] = opts.Name
+	}
+	if opts.PoolID != "" {
+		q["pool_id"] = opts.PoolID
+	}
+	if opts.Type != "" {
+		q["type"] = opts.Type
+	}
+	if opts.Delay != 0 {
+		q["delay"] = fmt.Sprintf("%d", opts.Delay)
+	}
+	if opts.Timeout != 0 {
+		q["timeout"] = fmt.Sprintf("%d", opts.Timeout)
+	}
+	if opts.MaxRetries != 0 {
+		q["max_retries"] = fmt.Sprintf("%d", opts.MaxRetries)
+	}
+	if opts.HTTPMethod != "" {
+		q["http_method"] = opts.HTTPMethod
+	}
+	if opts.URLPath != "" {
+		q["url_path"] = opts.URLPath
+	}
+	if opts.ExpectedCodes != "" {
+		q["expected_codes"] = opts.ExpectedCodes
+	}
+	if opts.TenantID != "" {
+		q["tenant_id"] = opts.TenantID
+	}
+	if opts.AdminStateUp != nil {
+		q["admin_state_up"] = fmt.Sprintf("%t", *opts.AdminStateUp)
+	}
+	if opts.Marker != "" {
+		q["marker"] = opts.Marker
+	}
+	if opts.Limit != 0 {
+		q["limit"] = fmt.Sprintf("%d", opts.Limit)
+	}
+	if opts.SortKey != "" {
+		q["sort_key"] = opts.SortKey
+	}
+	if opts.SortDir != "" {
+		q["sort_dir"] = opts.SortDir
+	}
+
+	u := rootURL(c) + utils.BuildQuery(q)
+
+	return pagination.NewPager(c, u, func(r pagination.LastHTTPResponse) pagination.Page {
+		return MonitorPage{pagination.LinkedPageBase{LastHTTPResponse: r}}
+	})
+}
+
+// CreateOpts contains all the values needed to create a new health monitor.
+type CreateOpts struct {
+	// Required. The type of probe sent by the monitor to the member, one of
+	// PING, TCP, HTTP, or HTTPS.
+	Type string
+
+	// Required. The time, in seconds, between sending probes to members.
+	Delay int
+
+	// Required. The maximum number of seconds for a monitor to wait for a
+	// connection to be established before it times out.
+	Timeout int
+
+	// Required. The number of allowed connection failures before changing
+	// the status of the member to INACTIVE.
+	MaxRetries int
+
+	// Required. The pool with which the monitor is associated.
+	PoolID string
+
+	// Optional. Required for HTTP(S) types. The HTTP method used for
+	// requests.
+	HTTPMethod string
+
+	// Optional. Required for HTTP(S) types. The HTTP path used in the
+	// request.
+	URLPath string
+
+	// Optional. Required for HTTP(S) types. The expected HTTP status codes
+	// for a passing HTTP(S) monitor, e.g. "200" or "200,301-303".
+	ExpectedCodes string
+
+	// Required for admins. Indicates the owner of the monitor.
+	TenantID string
+
+	// Optional. The administrative state of the monitor. A valid value is
+	// true (UP) or false (DOWN).
+	AdminStateUp *bool
+}
+
+var (
+	errTypeRequired       = fmt.Errorf("Type is required")
+	errDelayRequired      = fmt.Errorf("Delay is required")
+	errTimeoutRequired    = fmt.Errorf("Timeout is required")
+	errMaxRetriesRequired = fmt.Errorf("MaxRetries is required")
+	errPoolIDRequired     = fmt.Errorf("PoolID is required")
+)
+
+// Create is an operation which provisions a new health monitor based on the
+// configuration defined in the CreateOpts struct. Once the request is
+// validated and progress has started on the provisioning process, a
+// CreateResult will be returned.
+func Create(c *gophercloud.ServiceClient, opts CreateOpts) CreateResult {
+	var res CreateResult
+
+	if opts.Type == "" {
+		res.Err = errTypeRequired
+		return res
+	}
+	if opts.Delay == 0 {
+		res.Err = errDelayRequired
+		return res
+	}
+	if opts.Timeout == 0 {
+		res.Err = errTimeoutRequired
+		return res
+	}
+	if opts.MaxRetries == 0 {
+		res.Err = errMaxRetriesRequired
+		return res
+	}
+	if opts.PoolID == "" {
+		res.Err = errPoolIDRequired
+		return res
+	}
+
+	type monitor struct {
+		Type          string  `json:"type"`
+		Delay         int     `json:"delay"`
+		Timeout       int     `json:"timeout"`
+		MaxRetries    int     `json:"max_retries"`
+		PoolID        string  `json:"pool_id"`
+		HTTPMethod    *string `json:"http_method,omitempty"`
+		URLPath       *string `json:"url_path,omitempty"`
+		ExpectedCodes *string `json:"expected_codes,omitempty"`
+		TenantID      *string `json:"tenant_id,omitempty"`
+		AdminStateUp  *bool   `json:"admin_state_up,omitempty"`
+	}
+
+	type request struct {
+		Monitor monitor `json:"healthmonitor"`
+	}
+
+	reqBody := request{Monitor: monitor{
+		Type:          opts.Type,
+		Delay:         opts.Delay,
+		Timeout:       opts.Timeout,
+		MaxRetries:    opts.MaxRetries,
+		PoolID:        opts.PoolID,
+		HTTPMethod:    gophercloud.MaybeString(opts.HTTPMethod),
+		URLPath:       gophercloud.MaybeString(opts.URLPath),
+		ExpectedCodes: gophercloud.MaybeString(opts.ExpectedCodes),
+		TenantID:      gophercloud.MaybeString(opts.TenantID),
+		AdminStateUp:  opts.AdminStateUp,
+	}}
+
+	_, res.Err = perigee.Request("POST", rootURL(c), perigee.Options{
+		MoreHeaders:  c.Provider.AuthenticatedHeaders(),
+		CustomClient: &c.Provider.HTTPClient,
+		ReqBody:      &reqBody,
+		Results:      &res.Resp,
+		OkCodes:      []int{201},
+	})
+
+	return res
+}
+
+// Get retrieves a particular health monitor based on its unique ID.
+func Get(c *gophercloud.ServiceClient, id string) GetResult {
+	var res GetResult
+
+	_, res.Err = perigee.Request("GET", resourceURL(c, id), perigee.Options{
+		MoreHeaders:  c.Provider.AuthenticatedHeaders(),
+		CustomClient: &c.Provider.HTTPClient,
+		Results:      &res.Resp,
+		OkCodes:      []int{200},
+	})
+
+	return res
+}
+
+// UpdateOpts contains the values used when updating a health monitor.
+type UpdateOpts struct {
+	Delay         int
+	Timeout       int
+	MaxRetries    int
+	HTTPMethod    string
+	URLPath       string
+	ExpectedCodes string
+	AdminStateUp  *bool
+}
+
+// Update is an operation which modifies the attributes of the specified
+// health monitor.
+func Update(c *gophercloud.ServiceClient, id string, opts UpdateOpts) UpdateResult {
+	var res UpdateResult
+
+	type monitor struct {
+		Delay         int     `json:"delay,omitempty"`
+		Timeout       int     `json:"timeout,omitempty"`
+		MaxRetries    int     `json:"max_retries,omitempty"`
+		HTTPMethod    *string `json:"http_method,omitempty"`
+		URLPath       *string `json:"url_path,omitempty"`
+		ExpectedCodes *string `json:"expected_codes,omitempty"`
+		AdminStateUp  *bool   `json:"admin_state_up,omitempty"`
+	}
+
+	type request struct {
+		Monitor monitor `json:"healthmonitor"`
+	}
+
+	reqBody := request{Monitor: monitor{
+		Delay:         opts.Delay,
+		Timeout:       opts.Timeout,
+		MaxRetries:    opts.MaxRetries,
+		HTTPMethod:    gophercloud.MaybeString(opts.HTTPMethod),
+		URLPath:       gophercloud.MaybeString(opts.URLPath),
+		ExpectedCodes: gophercloud.MaybeString(opts.ExpectedCodes),
+		AdminStateUp:  opts.AdminStateUp,
+	}}
+
+	_, res.Err = perigee.Request("PUT", resourceURL(c, id), perigee.Options{
+		MoreHeaders:  c.Provider.AuthenticatedHeaders(),
+		CustomClient: &c.Provider.HTTPClient,
+		ReqBody:      &reqBody,
+		Results:      &res.Resp,
+		OkCodes:      []int{200},
+	})
+
+	return res
+}
+
+// Delete will permanently delete a particular health monitor based on its
+// unique ID.
+func Delete(c *gophercloud.ServiceClient, id string) DeleteResult {
+	var res DeleteResult
+
+	_, res.Err = perigee.Request("DELETE", resourceURL(c, id), perigee.Options{
+		MoreHeaders:  c.Provider.AuthenticatedHeaders(),
+		CustomClient: &c.Provider.HTTPClient,
+		OkCodes:      []int{204},
+	})
+
+	return res
+}
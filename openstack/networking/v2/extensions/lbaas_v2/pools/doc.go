@@ -0,0 +1,7 @@
+// Package pools provides information and interaction with the pools API
+// resource for the OpenStack Neutron LBaaS v2 extension.
+//
+// A pool is associated with a listener (rather than directly with a load
+// balancer, as in v1) and holds the load-balancing algorithm and session
+// persistence settings applied to its members.
+package pools
@@ -0,0 +1,255 @@
+package pools
+
+import (
+	"fmt"
+
+	"github.com/racker/perigee"
+	"github.com/rackspace/gophercloud"
+	"github.com/rackspace/gophercloud/openstack/utils"
+	"github.com/rackspace/gophercloud/pagination"
+)
+
+// ListOpts allows the filtering and sorting of paginated collections through
+// the API. Filtering is achieved by passing in struct field values that map to
+// the pool attributes you want to see returned.
+type ListOpts struct {
+	ID             string
+	Name           string
+	Description    string
+	ListenerID     string
+	LoadbalancerID string
+	Protocol       string
+	LBMethod       string
+	TenantID       string
+	AdminStateUp   *bool
+	Limit          int
+	Marker         string
+	SortKey        string
+	SortDir        string
+}
+
+// List returns a Pager which allows you to iterate over a collection of
+// pools. It accepts a ListOpts struct, which allows you to filter and sort
+// the returned collection for greater efficiency.
+func List(c *gophercloud.ServiceClient, opts ListOpts) pagination.Pager {
+	q := make(map[string]string)
+	if opts.ID != "" {
+		q["id"] = opts.ID
+	}
+	if opts.Name != "" {
+		q["name"] = opts.Name
+	}
+	if opts.Description != "" {
+		q["description"] = opts.Description
+	}
+	if opts.ListenerID != "" {
+		q["listener_id"] = opts.ListenerID
+	}
+	if opts.LoadbalancerID != "" {
+		q["loadbalancer_id"] = opts.LoadbalancerID
+	}
+	if opts.Protocol != "" {
+		q["protocol"] = opts.Protocol
+	}
+	if opts.LBMethod != "" {
+		q["lb_algorithm"] = opts.LBMethod
+	}
+	if opts.TenantID != "" {
+		q["tenant_id"] = opts.TenantID
+	}
+	if opts.AdminStateUp != nil {
+		q["admin_state_up"] = fmt.Sprintf("%t", *opts.AdminStateUp)
+	}
+	if opts.Marker != "" {
+		q["marker"] = opts.Marker
+	}
+	if opts.Limit != 0 {
+		q["limit"] = fmt.Sprintf("%d", opts.Limit)
+	}
+	if opts.SortKey != "" {
+		q["sort_key"] = opts.SortKey
+	}
+	if opts.SortDir != "" {
+		q["sort_dir"] = opts.SortDir
+	}
+
+	u := rootURL(c) + utils.BuildQuery(q)
+
+	return pagination.NewPager(c, u, func(r pagination.LastHTTPResponse) pagination.Page {
+		return PoolPage{pagination.LinkedPageBase{LastHTTPResponse: r}}
+	})
+}
+
+// SessionPersistence represents the session persistence feature of the
+// load balancing service. It attempts to force connections or requests
+// from the same end user to be processed by the same member as long as it
+// is active.
+type SessionPersistence struct {
+	Type       string `json:"type"`
+	CookieName string `json:"cookie_name,omitempty"`
+}
+
+// CreateOpts contains all the values needed to create a new pool.
+type CreateOpts struct {
+	// Required. The algorithm used to distribute load between the members of
+	// the pool. One of ROUND_ROBIN, LEAST_CONNECTIONS, or SOURCE_IP.
+	LBMethod string
+
+	// Required. The protocol used by the pool members, can either be TCP,
+	// HTTP, or HTTPS.
+	Protocol string
+
+	// Required. The listener with which the pool is associated.
+	ListenerID string
+
+	// Optional. Human-readable name for the pool.
+	Name string
+
+	// Optional. Human-readable description for the pool.
+	Description string
+
+	// Required for admins. Indicates the owner of the pool.
+	TenantID string
+
+	// Optional. Omit this field to prevent session persistence.
+	Persistence *SessionPersistence
+
+	// Optional. The administrative state of the pool. A valid value is true
+	// (UP) or false (DOWN).
+	AdminStateUp *bool
+}
+
+var (
+	errLBMethodRequired   = fmt.Errorf("LBMethod is required")
+	errPoolProtoRequired  = fmt.Errorf("Protocol is required")
+	errListenerIDRequired = fmt.Errorf("ListenerID is required")
+)
+
+// Create is an operation which provisions a new pool based on the
+// configuration defined in the CreateOpts struct. Once the request is
+// validated and progress has started on the provisioning process, a
+// CreateResult will be returned.
+func Create(c *gophercloud.ServiceClient, opts CreateOpts) CreateResult {
+	var res CreateResult
+
+	if opts.LBMethod == "" {
+		res.Err = errLBMethodRequired
+		return res
+	}
+	if opts.Protocol == "" {
+		res.Err = errPoolProtoRequired
+		return res
+	}
+	if opts.ListenerID == "" {
+		res.Err = errListenerIDRequired
+		return res
+	}
+
+	type pool struct {
+		LBMethod     string              `json:"lb_algorithm"`
+		Protocol     string              `json:"protocol"`
+		ListenerID   string              `json:"listener_id"`
+		Name         *string             `json:"name,omitempty"`
+		Description  *string             `json:"description,omitempty"`
+		TenantID     *string             `json:"tenant_id,omitempty"`
+		Persistence  *SessionPersistence `json:"session_persistence,omitempty"`
+		AdminStateUp *bool               `json:"admin_state_up,omitempty"`
+	}
+
+	type request struct {
+		Pool pool `json:"pool"`
+	}
+
+	reqBody := request{Pool: pool{
+		LBMethod:     opts.LBMethod,
+		Protocol:     opts.Protocol,
+		ListenerID:   opts.ListenerID,
+		Name:         gophercloud.MaybeString(opts.Name),
+		Description:  gophercloud.MaybeString(opts.Description),
+		TenantID:     gophercloud.MaybeString(opts.TenantID),
+		Persistence:  opts.Persistence,
+		AdminStateUp: opts.AdminStateUp,
+	}}
+
+	_, res.Err = perigee.Request("POST", rootURL(c), perigee.Options{
+		MoreHeaders:  c.Provider.AuthenticatedHeaders(),
+		CustomClient: &c.Provider.HTTPClient,
+		ReqBody:      &reqBody,
+		Results:      &res.Resp,
+		OkCodes:      []int{201},
+	})
+
+	return res
+}
+
+// Get retrieves a particular pool based on its unique ID.
+func Get(c *gophercloud.ServiceClient, id string) GetResult {
+	var res GetResult
+
+	_, res.Err = perigee.Request("GET", resourceURL(c, id), perigee.Options{
+		MoreHeaders:  c.Provider.AuthenticatedHeaders(),
+		CustomClient: &c.Provider.HTTPClient,
+		Results:      &res.Resp,
+		OkCodes:      []int{200},
+	})
+
+	return res
+}
+
+// UpdateOpts contains the values used when updating a pool.
+type UpdateOpts struct {
+	Name         string
+	Description  string
+	LBMethod     string
+	Persistence  *SessionPersistence
+	AdminStateUp *bool
+}
+
+// Update is an operation which modifies the attributes of the specified
+// pool.
+func Update(c *gophercloud.ServiceClient, id string, opts UpdateOpts) UpdateResult {
+	var res UpdateResult
+
+	type pool struct {
+		Name         *string             `json:"name,omitempty"`
+		Description  *string             `json:"description,omitempty"`
+		LBMethod     *string             `json:"lb_algorithm,omitempty"`
+		Persistence  *SessionPersistence `json:"session_persistence,omitempty"`
+		AdminStateUp *bool               `json:"admin_state_up,omitempty"`
+	}
+
+	type request struct {
+		Pool pool `json:"pool"`
+	}
+
+	reqBody := request{Pool: pool{
+		Name:         gophercloud.MaybeString(opts.Name),
+		Description:  gophercloud.MaybeString(opts.Description),
+		LBMethod:     gophercloud.MaybeString(opts.LBMethod),
+		Persistence:  opts.Persistence,
+		AdminStateUp: opts.AdminStateUp,
+	}}
+
+	_, res.Err = perigee.Request("PUT", resourceURL(c, id), perigee.Options{
+		MoreHeaders:  c.Provider.AuthenticatedHeaders(),
+		CustomClient: &c.Provider.HTTPClient,
+		ReqBody:      &reqBody,
+		Results:      &res.Resp,
+		OkCodes:      []int{200},
+	})
+
+	return res
+}
+
+// Delete will permanently delete a particular pool based on its unique ID.
+func Delete(c *gophercloud.ServiceClient, id string) DeleteResult {
+	var res DeleteResult
+
+	_, res.Err = perigee.Request("DELETE", resourceURL(c, id), perigee.Options{
+		MoreHeaders:  c.Provider.AuthenticatedHeaders(),
+		CustomClient: &c.Provider.HTTPClient,
+		OkCodes:      []int{204},
+	})
+
+	return res
+}
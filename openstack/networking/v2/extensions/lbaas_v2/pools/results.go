@@ -0,0 +1,101 @@
+package pools
+
+import (
+	"github.com/mitchellh/mapstructure"
+	"github.com/rackspace/gophercloud"
+	"github.com/rackspace/gophercloud/pagination"
+)
+
+// Pool is an internal representation of a pool.
+type Pool struct {
+	ID           string             `mapstructure:"id"`
+	Name         string             `mapstructure:"name"`
+	Description  string             `mapstructure:"description"`
+	Protocol     string             `mapstructure:"protocol"`
+	LBMethod     string             `mapstructure:"lb_algorithm"`
+	TenantID     string             `mapstructure:"tenant_id"`
+	AdminStateUp bool               `mapstructure:"admin_state_up"`
+	Persistence  SessionPersistence `mapstructure:"session_persistence"`
+	Listeners    []gophercloud.Link `mapstructure:"listeners"`
+	Members      []gophercloud.Link `mapstructure:"members"`
+	Monitors     []gophercloud.Link `mapstructure:"healthmonitors"`
+}
+
+// PoolPage is the page returned by a pager when traversing over a
+// collection of pools.
+type PoolPage struct {
+	pagination.LinkedPageBase
+}
+
+// NextPageURL is invoked when a paginated collection of pools has reached
+// the end of a page and the pager seeks to traverse over a new one.
+func (p PoolPage) NextPageURL() (string, error) {
+	type resp struct {
+		Links []gophercloud.Link `mapstructure:"pools_links"`
+	}
+
+	var r resp
+	err := mapstructure.Decode(p.Body, &r)
+	if err != nil {
+		return "", err
+	}
+
+	return gophercloud.ExtractNextURL(r.Links)
+}
+
+// IsEmpty checks whether a PoolPage struct is empty.
+func (p PoolPage) IsEmpty() (bool, error) {
+	is, err := ExtractPools(p)
+	return len(is) == 0, err
+}
+
+// ExtractPools accepts a Page struct, specifically a PoolPage struct, and
+// extracts the elements into a slice of Pool structs.
+func ExtractPools(page pagination.Page) ([]Pool, error) {
+	var resp struct {
+		Pools []Pool `mapstructure:"pools"`
+	}
+
+	err := mapstructure.Decode(page.(PoolPage).Body, &resp)
+
+	return resp.Pools, err
+}
+
+type commonResult struct {
+	gophercloud.Result
+}
+
+// Extract is a function that accepts a result and extracts a pool.
+func (r commonResult) Extract() (*Pool, error) {
+	if r.Err != nil {
+		return nil, r.Err
+	}
+
+	var res struct {
+		Pool Pool `mapstructure:"pool"`
+	}
+
+	err := mapstructure.Decode(r.Resp, &res)
+
+	return &res.Pool, err
+}
+
+// CreateResult represents the result of a create operation.
+type CreateResult struct {
+	commonResult
+}
+
+// GetResult represents the result of a get operation.
+type GetResult struct {
+	commonResult
+}
+
+// UpdateResult represents the result of an update operation.
+type UpdateResult struct {
+	commonResult
+}
+
+// DeleteResult represents the result of a delete operation.
+type DeleteResult struct {
+	gophercloud.ErrResult
+}
@@ -0,0 +1,66 @@
+package utils
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"io/ioutil"
+)
+
+// TLSConfigOpts gathers the pieces needed to build a *tls.Config for a
+// custom *http.Client passed to gophercloud.ProviderClient.HTTPClient: an
+// optional CA bundle to trust in place of the system roots, an optional
+// client certificate/key pair for mutual TLS, and whether to skip server
+// certificate verification entirely.
+type TLSConfigOpts struct {
+	// Optional. Path to a PEM-encoded CA bundle to trust, e.g. for a
+	// privately-signed OpenStack endpoint. When set, only the CAs in this
+	// bundle are trusted; the system roots are not consulted.
+	CACertFile string
+
+	// Optional. Paths to a PEM-encoded client certificate and private key,
+	// required together, for mutual TLS.
+	CertFile string
+	KeyFile  string
+
+	// Optional. Disables server certificate verification. Only use this
+	// against endpoints you trust by other means; it defeats the purpose of
+	// TLS.
+	Insecure bool
+}
+
+// NewTLSConfig builds a *tls.Config from a TLSConfigOpts, suitable for use
+// as the TLSClientConfig of the Transport on a custom *http.Client passed to
+// gophercloud.ProviderClient.HTTPClient.
+func NewTLSConfig(opts TLSConfigOpts) (*tls.Config, error) {
+	config := &tls.Config{InsecureSkipVerify: opts.Insecure}
+
+	if opts.CACertFile != "" {
+		pem, err := ioutil.ReadFile(opts.CACertFile)
+		if err != nil {
+			return nil, err
+		}
+
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("no certificates found in %s", opts.CACertFile)
+		}
+
+		config.RootCAs = pool
+	}
+
+	if opts.CertFile != "" || opts.KeyFile != "" {
+		if opts.CertFile == "" || opts.KeyFile == "" {
+			return nil, fmt.Errorf("CertFile and KeyFile must both be set for client certificate authentication")
+		}
+
+		cert, err := tls.LoadX509KeyPair(opts.CertFile, opts.KeyFile)
+		if err != nil {
+			return nil, err
+		}
+
+		config.Certificates = []tls.Certificate{cert}
+	}
+
+	return config, nil
+}